@@ -0,0 +1,47 @@
+package fcrand
+
+// base58Alphabet is the Bitcoin base58 alphabet, which omits the easily confused 0, O, I and l.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Base58 returns the Bitcoin-style base58 encoding of nbytes random bytes, as used for
+// wallet addresses and content identifiers. Each leading zero byte of the random data
+// is encoded as a leading '1', so the data round-trips exactly. It panics if nbytes < 0.
+func Base58(nbytes int) string {
+	b := make([]byte, nbytes)
+	Read(b)
+	return base58Encode(b)
+}
+
+// base58Encode returns the base58 encoding of src, treating src as a big-endian integer
+// and prefixing one '1' per leading zero byte. It runs in O(len(src)²) time.
+func base58Encode(src []byte) string {
+	zeros := 0
+	for zeros < len(src) && src[zeros] == 0 {
+		zeros++
+	}
+	// Base58 digits of src[zeros:], least significant last; log(256)/log(58) < 1.38.
+	digits := make([]byte, (len(src)-zeros)*138/100+1)
+	top := len(digits) // index of the most significant digit so far
+	for _, b := range src[zeros:] {
+		carry := int(b)
+		i := len(digits) - 1
+		for ; i >= top || carry != 0; i-- {
+			carry += 256 * int(digits[i])
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		top = i + 1
+	}
+	for top < len(digits) && digits[top] == 0 {
+		top++
+	}
+
+	dst := make([]byte, zeros, zeros+len(digits)-top)
+	for i := range dst {
+		dst[i] = '1'
+	}
+	for _, d := range digits[top:] {
+		dst = append(dst, base58Alphabet[d])
+	}
+	return string(dst)
+}
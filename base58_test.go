@@ -0,0 +1,62 @@
+package fcrand
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// base58Decode decodes s with math/big as an independent reference for base58Encode.
+func base58Decode(tb testing.TB, s string) []byte {
+	tb.Helper()
+	n := new(big.Int)
+	for _, c := range []byte(s) {
+		d := strings.IndexByte(base58Alphabet, c)
+		if d < 0 {
+			tb.Fatalf("invalid base58 character %q in %q", c, s)
+		}
+		n.Mul(n, big.NewInt(58))
+		n.Add(n, big.NewInt(int64(d)))
+	}
+	ones := len(s) - len(strings.TrimLeft(s, "1"))
+	return append(make([]byte, ones), n.Bytes()...)
+}
+
+// Test base58Encode against known vectors and a math/big reference
+func TestBase58(t *testing.T) {
+	for _, tc := range []struct {
+		in   []byte
+		want string
+	}{
+		{nil, ""},
+		{[]byte{0}, "1"},
+		{[]byte{0, 0, 1}, "112"},
+		{[]byte{57}, "z"},
+		{[]byte{58}, "21"},
+		{[]byte("Hello World!"), "2NEpo7TZRRrLZSi2U"},
+		{bytes.Repeat([]byte{0xFF}, 32), "JEKNVnkbo3jma5nREBBJCDoXFVeKkD56V3xKrvRmWxFG"},
+	} {
+		if got := base58Encode(tc.in); got != tc.want {
+			t.Fatalf("base58Encode(%x) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+
+	for _, nbytes := range []int{0, 1, 2, 5, 16, 32, 100} {
+		for range 100 {
+			b := make([]byte, nbytes)
+			Read(b)
+			if nbytes > 2 {
+				b[0] = 0 // exercise leading-zero handling
+			}
+			s := base58Encode(b)
+			if got := base58Decode(t, s); !bytes.Equal(got, b) {
+				t.Fatalf("base58 %q decoded to %x, want %x", s, got, b)
+			}
+		}
+		s := Base58(nbytes)
+		if got := base58Decode(t, s); len(got) != nbytes {
+			t.Fatalf("Base58(%d) = %q decodes to %d bytes", nbytes, s, len(got))
+		}
+	}
+}
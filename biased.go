@@ -0,0 +1,92 @@
+package fcrand
+
+// aliasTable is a Walker/Vose alias table for sampling bytes from integer weights,
+// using exact integer arithmetic.
+type aliasTable struct {
+	total uint64      // sum of all weights
+	prob  [256]uint64 // column i yields i if r < prob[i] for uniform r in [0, total), else alias[i]
+	alias [256]byte
+}
+
+// newAliasTable builds the alias table for freq. It panics if all weights are zero.
+func newAliasTable(freq *[256]uint32) *aliasTable {
+	t := &aliasTable{}
+	for _, f := range freq {
+		t.total += uint64(f)
+	}
+	if t.total == 0 {
+		panic("fcrand: byte frequencies must not all be zero")
+	}
+
+	// scaled[i] = 256*freq[i], so that the average column holds exactly total.
+	var scaled [256]uint64
+	var small, large []byte
+	for i, f := range freq {
+		scaled[i] = uint64(f) * 256
+		if scaled[i] < t.total {
+			small = append(small, byte(i))
+		} else {
+			large = append(large, byte(i))
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		l, g := small[len(small)-1], large[len(large)-1]
+		small, large = small[:len(small)-1], large[:len(large)-1]
+		t.prob[l], t.alias[l] = scaled[l], g
+		scaled[g] -= t.total - scaled[l]
+		if scaled[g] < t.total {
+			small = append(small, g)
+		} else {
+			large = append(large, g)
+		}
+	}
+	for _, i := range large {
+		t.prob[i], t.alias[i] = t.total, i
+	}
+	for _, i := range small { // unreachable with exact integer weights; kept for safety
+		t.prob[i], t.alias[i] = t.total, i
+	}
+	return t
+}
+
+// read fills b with bytes sampled from the table.
+// Each byte takes one uniform draw in [0, 256*total), split into a column and a threshold.
+func (t *aliasTable) read(b []byte) {
+	for i := range b {
+		x := uint64n(256 * t.total)
+		column, r := x/t.total, x%t.total
+		if r < t.prob[column] {
+			b[i] = byte(column)
+		} else {
+			b[i] = t.alias[column]
+		}
+	}
+}
+
+// ReadBiased fills b with random bytes where each byte value v occurs with probability
+// freq[v]/sum(freq), eg. to mimic the byte distribution of real data in tests.
+// It builds an alias table on every call; use a ByteSampler to amortize the setup
+// across many fills. It panics if all frequencies are zero.
+func ReadBiased(b []byte, freq [256]uint32) {
+	newAliasTable(&freq).read(b)
+}
+
+// ByteSampler fills buffers with random bytes following a fixed byte distribution.
+// Its alias table is built once, so repeated fills avoid the setup cost of ReadBiased.
+// A ByteSampler is safe for concurrent use.
+type ByteSampler struct {
+	table *aliasTable
+}
+
+// NewByteSampler returns a ByteSampler where each byte value v occurs with probability
+// freq[v]/sum(freq). It panics if all frequencies are zero.
+func NewByteSampler(freq [256]uint32) *ByteSampler {
+	return &ByteSampler{table: newAliasTable(&freq)}
+}
+
+// Read fills b with bytes sampled from the distribution.
+// It never returns an error, and always fills b entirely.
+func (s *ByteSampler) Read(b []byte) (n int, err error) {
+	s.table.read(b)
+	return len(b), nil
+}
@@ -0,0 +1,89 @@
+package fcrand
+
+import (
+	"math"
+	"testing"
+)
+
+// assertByteDistribution fails tb if the byte frequencies in b deviate from freq
+func assertByteDistribution(tb testing.TB, b []byte, freq *[256]uint32) {
+	tb.Helper()
+	var counts [256]int
+	for _, c := range b {
+		counts[c]++
+	}
+	total := 0.0
+	for _, f := range freq {
+		total += float64(f)
+	}
+	for v, f := range freq {
+		want := float64(f) / total * float64(len(b))
+		if f == 0 && counts[v] != 0 {
+			tb.Fatalf("zero-frequency byte %d occurred %d times", v, counts[v])
+		}
+		if math.Abs(float64(counts[v])-want) > 6*math.Sqrt(want)+1 {
+			tb.Fatalf("byte %d occurred %d times, want about %.0f", v, counts[v], want)
+		}
+	}
+}
+
+// asciiSkewedFreq returns frequencies skewed toward printable ASCII
+func asciiSkewedFreq() *[256]uint32 {
+	var freq [256]uint32
+	for v := range freq {
+		switch {
+		case v >= 'a' && v <= 'z':
+			freq[v] = 40
+		case v >= 0x20 && v < 0x7F:
+			freq[v] = 10
+		case v%3 == 0:
+			freq[v] = 1
+		}
+	}
+	return &freq
+}
+
+// Test ReadBiased reproduces the target byte distribution
+func TestReadBiased(t *testing.T) {
+	freq := asciiSkewedFreq()
+	b := make([]byte, 200000)
+	ReadBiased(b, *freq)
+	assertByteDistribution(t, b, freq)
+
+	var single [256]uint32
+	single['x'] = 7
+	b = make([]byte, 100)
+	ReadBiased(b, single)
+	for _, c := range b {
+		if c != 'x' {
+			t.Fatalf("ReadBiased with a single weight produced %q", c)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ReadBiased with all-zero frequencies did not panic")
+		}
+	}()
+	ReadBiased(b, [256]uint32{})
+}
+
+// Test ByteSampler reproduces the target distribution without per-call setup
+func TestByteSampler(t *testing.T) {
+	freq := asciiSkewedFreq()
+	s := NewByteSampler(*freq)
+	b := make([]byte, 200000)
+	for i := 0; i < len(b); i += 1000 {
+		if n, err := s.Read(b[i : i+1000]); n != 1000 || err != nil {
+			t.Fatalf("Read returned n=%d, err=%v", n, err)
+		}
+	}
+	assertByteDistribution(t, b, freq)
+
+	small := make([]byte, 16)
+	samplerAllocs := testing.AllocsPerRun(100, func() { s.Read(small) })
+	rebuildAllocs := testing.AllocsPerRun(100, func() { ReadBiased(small, *freq) })
+	if (samplerAllocs != 0 && !raceEnabled) || rebuildAllocs == 0 { // Read may allocate under -race
+		t.Fatalf("ByteSampler.Read allocs = %v, ReadBiased allocs = %v", samplerAllocs, rebuildAllocs)
+	}
+}
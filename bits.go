@@ -0,0 +1,182 @@
+package fcrand
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Flips returns n independent biased coin flips, each true with probability p.
+// Each flip compares a 32-bit uniform value against p, so p is effectively
+// quantized to a multiple of 2⁻³², and randomness is drawn in bulk rather than per flip.
+// Flips returns an empty slice if n <= 0, and panics unless 0 <= p <= 1.
+func Flips(n int, p float64) []bool {
+	if !(p >= 0 && p <= 1) {
+		panic("fcrand: probability must be in [0, 1]")
+	}
+	flips := make([]bool, max(n, 0))
+	fillBernoulli(flips, p)
+	return flips
+}
+
+// fillBernoulli sets each element of dst to true with probability p in [0, 1].
+func fillBernoulli(dst []bool, p float64) {
+	threshold := uint64(p * (1 << 32)) // value < threshold ⇔ true
+	var buf [maxBytesToFillViaCache]byte
+	for len(dst) > 0 {
+		chunk := buf[:min(len(dst)*4, len(buf))]
+		Read(chunk)
+		for ; len(chunk) > 0; chunk = chunk[4:] {
+			dst[0] = uint64(binary.LittleEndian.Uint32(chunk)) < threshold
+			dst = dst[1:]
+		}
+	}
+}
+
+// ReadBits returns ⌈nbits/8⌉ random bytes holding exactly nbits random bits:
+// the unused high bits of the last byte are zero. It panics if nbits < 0.
+func ReadBits(nbits int) []byte {
+	if nbits < 0 {
+		panic("fcrand: ReadBits requires nbits >= 0")
+	}
+	b := make([]byte, (nbits+7)/8)
+	Read(b)
+	if r := nbits % 8; r != 0 {
+		b[len(b)-1] &= 1<<r - 1
+	}
+	return b
+}
+
+// Bits is a fixed-length bitset packed LSB-first into bytes:
+// bit i is stored at Bytes()[i/8]&(1<<(i%8)), and the padding bits past Len()
+// in the last byte are zero.
+type Bits struct {
+	b []byte
+	n int
+}
+
+// BitSet returns a bitset of nbits independent random bits packed into ⌈nbits/8⌉ bytes,
+// eg. for fuzzing bit-level protocols or Bloom filters. It panics if nbits < 0.
+func BitSet(nbits int) Bits {
+	return Bits{b: ReadBits(nbits), n: nbits} // ReadBits already uses the LSB-first layout
+}
+
+// Len returns the number of bits in s.
+func (s Bits) Len() int {
+	return s.n
+}
+
+// Get reports whether bit i is set. It panics unless 0 <= i < s.Len().
+func (s Bits) Get(i int) bool {
+	s.check(i)
+	return s.b[i/8]&(1<<(i%8)) != 0
+}
+
+// Set sets bit i to 1. It panics unless 0 <= i < s.Len().
+func (s Bits) Set(i int) {
+	s.check(i)
+	s.b[i/8] |= 1 << (i % 8)
+}
+
+// Clear sets bit i to 0. It panics unless 0 <= i < s.Len().
+func (s Bits) Clear(i int) {
+	s.check(i)
+	s.b[i/8] &^= 1 << (i % 8)
+}
+
+// Bytes returns the ⌈Len()/8⌉ bytes backing s, which share its storage.
+func (s Bits) Bytes() []byte {
+	return s.b
+}
+
+// check panics unless i is a valid bit index of s.
+func (s Bits) check(i int) {
+	if i < 0 || i >= s.n {
+		panic("fcrand: Bits index out of range")
+	}
+}
+
+// ReadDistant returns len(ref) random bytes differing from ref in at least minFlips bit
+// positions (Hamming distance ≥ minFlips), eg. for testing error-correction code.
+// It draws random bytes and, if they are too close to ref, flips additional uniformly
+// chosen bits among those still equal to ref. It panics unless 0 <= minFlips <= 8*len(ref).
+func ReadDistant(ref []byte, minFlips int) []byte {
+	if minFlips < 0 || minFlips > 8*len(ref) {
+		panic("fcrand: ReadDistant requires 0 <= minFlips <= 8*len(ref)")
+	}
+	b := make([]byte, len(ref))
+	Read(b)
+	dist := 0
+	for i := range b {
+		dist += bits.OnesCount8(b[i] ^ ref[i])
+	}
+	if dist >= minFlips {
+		return b
+	}
+
+	equal := make([]int, 0, 8*len(ref)-dist) // bit positions where b matches ref
+	for i := range b {
+		for j := range 8 {
+			if (b[i]^ref[i])&(1<<j) == 0 {
+				equal = append(equal, i*8+j)
+			}
+		}
+	}
+	picks, _ := DistinctInts(minFlips-dist, len(equal))
+	for _, p := range picks {
+		pos := equal[p]
+		b[pos/8] ^= 1 << (pos % 8)
+	}
+	return b
+}
+
+// RandomWalk returns the positions of a ±1 random walk starting at 0: element i is the
+// position after i steps, so the result has steps+1 elements. Each step direction is one
+// random bit, 8 steps per random byte. It panics if steps < 0.
+func RandomWalk(steps int) []int {
+	if steps < 0 {
+		panic("fcrand: RandomWalk requires steps >= 0")
+	}
+	dirs := ReadBits(steps)
+	walk := make([]int, steps+1)
+	for i := range steps {
+		walk[i+1] = walk[i] + int(dirs[i/8]>>(i%8)&1)*2 - 1
+	}
+	return walk
+}
+
+// ReadPopcount returns ⌈totalBits/8⌉ bytes in which exactly setBits of the first totalBits
+// bits (in the LSB-first layout of Bits) are 1, at uniformly random positions chosen with
+// DistinctInts. It panics unless 0 <= setBits <= totalBits.
+func ReadPopcount(totalBits, setBits int) []byte {
+	positions, err := DistinctInts(setBits, totalBits)
+	if err != nil {
+		panic("fcrand: ReadPopcount requires 0 <= setBits <= totalBits")
+	}
+	b := make([]byte, (totalBits+7)/8)
+	for _, pos := range positions {
+		b[pos/8] |= 1 << (pos % 8)
+	}
+	return b
+}
+
+// DensityMask returns a bitmask of nbits bits packed into ⌈nbits/8⌉ bytes (in the LSB-first
+// layout of Bits), with each bit set independently with probability density as in Flips,
+// eg. for testing sparse and dense bitmap structures. The padding bits past nbits in the
+// last byte are zero. It panics if nbits < 0 or density is not in [0, 1].
+func DensityMask(nbits int, density float64) []byte {
+	if nbits < 0 {
+		panic("fcrand: DensityMask requires nbits >= 0")
+	}
+	if !(density >= 0 && density <= 1) {
+		panic("fcrand: DensityMask requires density in [0, 1]")
+	}
+	set := make([]bool, nbits)
+	fillBernoulli(set, density)
+	mask := make([]byte, (nbits+7)/8)
+	for i, on := range set {
+		if on {
+			mask[i/8] |= 1 << (i % 8)
+		}
+	}
+	return mask
+}
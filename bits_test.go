@@ -0,0 +1,225 @@
+package fcrand
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+)
+
+// Test Flips true-rate matches p
+func TestFlips(t *testing.T) {
+	const n = 100000
+	for _, p := range []float64{0, 0.1, 0.5, 0.9, 1} {
+		flips := Flips(n, p)
+		if len(flips) != n {
+			t.Fatalf("Flips(%d, %v) returned %d flips", n, p, len(flips))
+		}
+		trues := 0
+		for _, f := range flips {
+			if f {
+				trues++
+			}
+		}
+		if rate := float64(trues) / n; math.Abs(rate-p) > 0.01 {
+			t.Fatalf("Flips(%d, %v) true-rate %v", n, p, rate)
+		}
+	}
+	if len(Flips(0, 0.5)) != 0 || len(Flips(-1, 0.5)) != 0 {
+		t.Fatal("Flips with n <= 0 returned flips")
+	}
+
+	for _, p := range []float64{-0.1, 1.1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("Flips(1, %v) did not panic", p)
+				}
+			}()
+			Flips(1, p)
+		}()
+	}
+}
+
+// Test ReadBits length and zeroed high bits of the last byte
+func TestReadBits(t *testing.T) {
+	for _, nbits := range []int{0, 1, 7, 8, 9, 100, 128} {
+		var or byte // OR of all last bytes
+		for range 64 {
+			b := ReadBits(nbits)
+			if len(b) != (nbits+7)/8 {
+				t.Fatalf("ReadBits(%d) returned %d bytes", nbits, len(b))
+			}
+			if len(b) > 0 {
+				or |= b[len(b)-1]
+			}
+		}
+		want := byte(0xFF)
+		if nbits%8 != 0 {
+			want = 1<<(nbits%8) - 1
+		} else if nbits == 0 {
+			want = 0
+		}
+		if or != want { // all allowed bits set at least once, no others
+			t.Fatalf("ReadBits(%d) last byte bits %08b, want %08b", nbits, or, want)
+		}
+	}
+}
+
+// Test BitSet padding bits are zero, about half the bits are set, and the accessors
+func TestBitSet(t *testing.T) {
+	const nbits = 10003
+	set := BitSet(nbits)
+	b := set.Bytes()
+	if set.Len() != nbits || len(b) != (nbits+7)/8 {
+		t.Fatalf("BitSet(%d) has Len %d and %d bytes", nbits, set.Len(), len(b))
+	}
+	if pad := b[len(b)-1] >> (nbits % 8); pad != 0 {
+		t.Fatalf("BitSet(%d) padding bits %b, want 0", nbits, pad)
+	}
+	ones := 0
+	for i := range nbits {
+		if set.Get(i) {
+			ones++
+		}
+	}
+	popcount := 0
+	for _, c := range b {
+		popcount += bits.OnesCount8(c)
+	}
+	if ones != popcount {
+		t.Fatalf("Get counted %d set bits, popcount %d", ones, popcount)
+	}
+	if math.Abs(float64(ones)-nbits/2) > 300 { // 6 standard deviations
+		t.Fatalf("BitSet(%d) has %d set bits, want about %d", nbits, ones, nbits/2)
+	}
+
+	set.Clear(9)
+	set.Set(10)
+	if set.Get(9) || !set.Get(10) || b[1]&0b110 != 0b100 {
+		t.Fatalf("Set/Clear did not update the LSB-first layout: %08b", b[1])
+	}
+
+	for _, i := range []int{-1, nbits} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("Get(%d) did not panic", i)
+				}
+			}()
+			set.Get(i)
+		}()
+	}
+}
+
+// Test ReadDistant meets the minimum Hamming distance
+func TestReadDistant(t *testing.T) {
+	ref := make([]byte, 16)
+	Read(ref)
+	for _, minFlips := range []int{0, 1, 64, 100, 127, 128} {
+		for range 20 {
+			b := ReadDistant(ref, minFlips)
+			if len(b) != len(ref) {
+				t.Fatalf("ReadDistant returned %d bytes, want %d", len(b), len(ref))
+			}
+			dist := 0
+			for i := range b {
+				dist += bits.OnesCount8(b[i] ^ ref[i])
+			}
+			if dist < minFlips {
+				t.Fatalf("ReadDistant(minFlips=%d) has distance %d", minFlips, dist)
+			}
+		}
+	}
+	if b := ReadDistant(nil, 0); len(b) != 0 {
+		t.Fatalf("ReadDistant(nil, 0) returned %d bytes", len(b))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ReadDistant with minFlips > total bits did not panic")
+		}
+	}()
+	ReadDistant(ref, 129)
+}
+
+// Test RandomWalk length and unit steps
+func TestRandomWalk(t *testing.T) {
+	for _, steps := range []int{0, 1, 7, 1000} {
+		walk := RandomWalk(steps)
+		if len(walk) != steps+1 || walk[0] != 0 {
+			t.Fatalf("RandomWalk(%d) returned %d positions starting at %d", steps, len(walk), walk[0])
+		}
+		for i := 1; i < len(walk); i++ {
+			if d := walk[i] - walk[i-1]; d != 1 && d != -1 {
+				t.Fatalf("RandomWalk(%d) step %d moved by %d", steps, i, d)
+			}
+		}
+	}
+
+	ups := 0
+	walk := RandomWalk(10000)
+	for i := 1; i < len(walk); i++ {
+		if walk[i] > walk[i-1] {
+			ups++
+		}
+	}
+	if ups < 4700 || ups > 5300 { // 6 standard deviations
+		t.Fatalf("RandomWalk(10000) has %d up-steps, want about 5000", ups)
+	}
+}
+
+// Test ReadPopcount sets exactly setBits bits within totalBits
+func TestReadPopcount(t *testing.T) {
+	for _, tc := range [][2]int{{0, 0}, {10, 0}, {10, 10}, {13, 5}, {1000, 3}, {1000, 999}} {
+		totalBits, setBits := tc[0], tc[1]
+		b := ReadPopcount(totalBits, setBits)
+		if len(b) != (totalBits+7)/8 {
+			t.Fatalf("ReadPopcount(%d, %d) returned %d bytes", totalBits, setBits, len(b))
+		}
+		popcount := 0
+		for _, c := range b {
+			popcount += bits.OnesCount8(c)
+		}
+		if popcount != setBits {
+			t.Fatalf("ReadPopcount(%d, %d) has popcount %d", totalBits, setBits, popcount)
+		}
+		if r := totalBits % 8; r != 0 && b[len(b)-1]>>r != 0 {
+			t.Fatalf("ReadPopcount(%d, %d) set padding bits", totalBits, setBits)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ReadPopcount(8, 9) did not panic")
+		}
+	}()
+	ReadPopcount(8, 9)
+}
+
+// Test DensityMask set-bit fraction approximates density
+func TestDensityMask(t *testing.T) {
+	const nbits = 100000
+	for _, density := range []float64{0, 0.01, 0.25, 0.5, 0.9, 1} {
+		mask := DensityMask(nbits, density)
+		if len(mask) != nbits/8 {
+			t.Fatalf("DensityMask(%d) returned %d bytes", nbits, len(mask))
+		}
+		popcount := 0
+		for _, c := range mask {
+			popcount += bits.OnesCount8(c)
+		}
+		if got := float64(popcount) / nbits; math.Abs(got-density) > 0.01 {
+			t.Fatalf("DensityMask(%d, %v) set fraction %v", nbits, density, got)
+		}
+	}
+	if mask := DensityMask(13, 1); len(mask) != 2 || mask[0] != 0xFF || mask[1] != 0x1F {
+		t.Fatalf("DensityMask(13, 1) = %x, want ff1f", mask)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("DensityMask with density 1.5 did not panic")
+		}
+	}()
+	DensityMask(8, 1.5)
+}
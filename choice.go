@@ -0,0 +1,134 @@
+package fcrand
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+var (
+	errWeightsLength = errors.New("fcrand: items and weights must have equal non-zero length")
+	errWeightsValue  = errors.New("fcrand: weights must be finite, non-negative, and not all zero")
+	errCDF           = errors.New("fcrand: CDF must be non-empty, non-decreasing from >= 0, and end at 1.0")
+)
+
+// WeightedChoiceF returns an element of items selected with probability proportional
+// to its float weight in probs. The weights need not sum to 1.
+// It returns an error if items and probs differ in length or are empty, or if any weight
+// is negative or non-finite, or if all weights are zero.
+func WeightedChoiceF[T any](items []T, probs []float64) (T, error) {
+	var zero T
+	if len(items) == 0 || len(items) != len(probs) {
+		return zero, errWeightsLength
+	}
+	sum := 0.0
+	last := -1 // index of the last positive weight
+	for i, p := range probs {
+		if p < 0 || math.IsNaN(p) || math.IsInf(p, 0) {
+			return zero, errWeightsValue
+		}
+		if p > 0 {
+			last = i
+		}
+		sum += p
+	}
+	if last < 0 || math.IsInf(sum, 0) {
+		return zero, errWeightsValue
+	}
+
+	r := float64Unit() * sum
+	cum := 0.0
+	for i, p := range probs[:last] {
+		cum += p
+		if r < cum {
+			return items[i], nil
+		}
+	}
+	return items[last], nil // also absorbs floating-point rounding of cum
+}
+
+// ChoiceFunc returns a uniformly random element of s among those satisfying keep,
+// and false if none do. It makes a single pass over s without allocating,
+// using one random draw per matching element (reservoir sampling with k=1).
+func ChoiceFunc[T any](s []T, keep func(T) bool) (T, bool) {
+	var chosen T
+	var matches uint64
+	for _, v := range s {
+		if !keep(v) {
+			continue
+		}
+		matches++
+		if uint64n(matches) == 0 {
+			chosen = v
+		}
+	}
+	return chosen, matches > 0
+}
+
+// IndexExcept returns a uniform random index in [0, length) for which exclude is not true,
+// and false if there is none. Sparse exclusions are handled by rejection sampling;
+// when most indices are excluded, the allowed indices are enumerated instead.
+func IndexExcept(length int, exclude map[int]bool) (int, bool) {
+	excluded := 0
+	for i, ex := range exclude {
+		if ex && i >= 0 && i < length {
+			excluded++
+		}
+	}
+	allowed := length - excluded
+	if allowed <= 0 {
+		return 0, false
+	}
+
+	if allowed >= length/2 { // at most 2 expected draws
+		for {
+			if i := int(uint64n(uint64(length))); !exclude[i] {
+				return i, true
+			}
+		}
+	}
+	r := int(uint64n(uint64(allowed)))
+	for i := range length {
+		if exclude[i] {
+			continue
+		}
+		if r == 0 {
+			return i, true
+		}
+		r--
+	}
+	panic("unreachable")
+}
+
+// ShardKey returns a uniform random shard index in [0, shards), eg. for randomized
+// placement of work or data across shards. It panics if shards <= 0.
+func ShardKey(shards int) int {
+	if shards <= 0 {
+		panic("fcrand: ShardKey requires shards > 0")
+	}
+	return int(uint64n(uint64(shards)))
+}
+
+// cdfTolerance is how far the last CDF value may be from 1.0, allowing for rounding.
+const cdfTolerance = 1e-9
+
+// FromCDF returns an index sampled according to the precomputed cumulative distribution cdf,
+// where index i has probability cdf[i]-cdf[i-1] (cdf[0] for i == 0), by binary search on
+// a uniform value. For repeated draws from a fixed distribution this avoids the re-summing
+// done by WeightedChoiceF. It returns an error unless cdf is non-empty, non-decreasing,
+// starts at a value >= 0 and ends within 1e-9 of 1.0.
+func FromCDF(cdf []float64) (int, error) {
+	if len(cdf) == 0 || !(cdf[0] >= 0) || math.Abs(cdf[len(cdf)-1]-1) > cdfTolerance {
+		return 0, errCDF
+	}
+	for i := 1; i < len(cdf); i++ {
+		if !(cdf[i] >= cdf[i-1]) {
+			return 0, errCDF
+		}
+	}
+	u := float64Unit()
+	if last := cdf[len(cdf)-1]; u >= last { // only possible if last < 1.0 due to rounding
+		u = math.Nextafter(last, 0)
+	}
+	return sort.Search(len(cdf), func(i int) bool { return u < cdf[i] }), nil
+}
@@ -0,0 +1,165 @@
+package fcrand
+
+import (
+	"math"
+	"testing"
+)
+
+// Test WeightedChoiceF empirical distribution matches normalized weights
+func TestWeightedChoiceF(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	probs := []float64{1, 2, 0, 7}
+	const draws = 20000
+	counts := map[string]int{}
+	for range draws {
+		item, err := WeightedChoiceF(items, probs)
+		if err != nil {
+			t.Fatalf("WeightedChoiceF returned error: %v", err)
+		}
+		counts[item]++
+	}
+	if counts["c"] != 0 {
+		t.Fatalf("zero-weight item chosen %d times", counts["c"])
+	}
+	for i, item := range items {
+		got, want := float64(counts[item])/draws, probs[i]/10
+		if math.Abs(got-want) > 0.02 {
+			t.Fatalf("item %q frequency %.3f, want %.3f", item, got, want)
+		}
+	}
+}
+
+// Test WeightedChoiceF input validation
+func TestWeightedChoiceF_Invalid(t *testing.T) {
+	items := []int{1, 2}
+	for _, probs := range [][]float64{nil, {1}, {0, 0}, {1, -1}, {1, math.NaN()}, {math.Inf(1), 1}, {math.MaxFloat64, math.MaxFloat64}} {
+		if _, err := WeightedChoiceF(items, probs); err == nil {
+			t.Fatalf("WeightedChoiceF(%v) returned no error", probs)
+		}
+	}
+	if _, err := WeightedChoiceF([]int{}, []float64{}); err == nil {
+		t.Fatal("WeightedChoiceF on empty items returned no error")
+	}
+}
+
+// Test ChoiceFunc returns only matching elements, uniformly
+func TestChoiceFunc(t *testing.T) {
+	s := make([]int, 100)
+	for i := range s {
+		s[i] = i
+	}
+	isMultipleOf25 := func(v int) bool { return v%25 == 0 }
+
+	const draws = 8000
+	counts := map[int]int{}
+	for range draws {
+		v, ok := ChoiceFunc(s, isMultipleOf25)
+		if !ok || !isMultipleOf25(v) {
+			t.Fatalf("ChoiceFunc returned %d, %v", v, ok)
+		}
+		counts[v]++
+	}
+	for _, v := range []int{0, 25, 50, 75} {
+		if p := float64(counts[v]) / draws; math.Abs(p-0.25) > 0.03 {
+			t.Fatalf("element %d frequency %.3f, want 0.25", v, p)
+		}
+	}
+
+	if v, ok := ChoiceFunc(s, func(int) bool { return false }); ok || v != 0 {
+		t.Fatalf("ChoiceFunc with no matches returned %d, %v", v, ok)
+	}
+}
+
+// Test IndexExcept for sparse, dense and complete exclusions
+func TestIndexExcept(t *testing.T) {
+	const length = 50
+	exclude := map[int]bool{3: true, 7: false, -1: true, 99: true}
+	counts := map[int]int{}
+	for range 2000 {
+		i, ok := IndexExcept(length, exclude)
+		if !ok || i < 0 || i >= length || exclude[i] {
+			t.Fatalf("IndexExcept returned %d, %v", i, ok)
+		}
+		counts[i]++
+	}
+	if len(counts) != length-1 {
+		t.Fatalf("IndexExcept returned %d distinct indices, want %d", len(counts), length-1)
+	}
+
+	dense := map[int]bool{}
+	for i := range length {
+		dense[i] = i != 17 && i != 41
+	}
+	counts = map[int]int{}
+	for range 1000 {
+		i, ok := IndexExcept(length, dense)
+		if !ok || (i != 17 && i != 41) {
+			t.Fatalf("IndexExcept with dense exclusions returned %d, %v", i, ok)
+		}
+		counts[i]++
+	}
+	if counts[17] < 400 || counts[41] < 400 {
+		t.Fatalf("IndexExcept with dense exclusions not uniform: %v", counts)
+	}
+
+	dense[17], dense[41] = true, true
+	if _, ok := IndexExcept(length, dense); ok {
+		t.Fatal("IndexExcept with all indices excluded returned true")
+	}
+	if _, ok := IndexExcept(0, nil); ok {
+		t.Fatal("IndexExcept(0, nil) returned true")
+	}
+}
+
+// Test ShardKey distributes uniformly across shards
+func TestShardKey(t *testing.T) {
+	const shards, draws = 16, 32000
+	var counts [shards]int
+	for range draws {
+		counts[ShardKey(shards)]++
+	}
+	for i, c := range counts {
+		if c < draws/shards*85/100 || c > draws/shards*115/100 {
+			t.Fatalf("shard %d got %d keys, want about %d", i, c, draws/shards)
+		}
+	}
+	if k := ShardKey(1); k != 0 {
+		t.Fatalf("ShardKey(1) = %d", k)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ShardKey(0) did not panic")
+		}
+	}()
+	ShardKey(0)
+}
+
+// Test FromCDF empirical distribution and validation
+func TestFromCDF(t *testing.T) {
+	cdf := []float64{0.1, 0.1, 0.4, 1.0} // probabilities 0.1, 0, 0.3, 0.6
+	want := []float64{0.1, 0, 0.3, 0.6}
+	const draws = 20000
+	counts := make([]int, len(cdf))
+	for range draws {
+		i, err := FromCDF(cdf)
+		if err != nil {
+			t.Fatalf("FromCDF returned error: %v", err)
+		}
+		counts[i]++
+	}
+	for i, c := range counts {
+		if got := float64(c) / draws; math.Abs(got-want[i]) > 0.02 {
+			t.Fatalf("index %d frequency %.3f, want %.3f", i, got, want[i])
+		}
+	}
+
+	if i, err := FromCDF([]float64{0, 0, 1 - 1e-12}); err != nil || i != 2 {
+		t.Fatalf("FromCDF with rounded end returned %d, %v", i, err)
+	}
+	for _, cdf := range [][]float64{nil, {0.5}, {0.5, 0.4, 1}, {-0.1, 1}, {0.2, math.NaN(), 1}, {1.1}} {
+		if _, err := FromCDF(cdf); err == nil {
+			t.Fatalf("FromCDF(%v) returned no error", cdf)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package fcrand
+
+import "io"
+
+// ChunkedReader returns a reader which serves total random bytes from the cache in
+// randomly sized chunks, then io.EOF, to exercise consumers' handling of short reads.
+// Each Read returns a uniform random chunk size in [minChunk, maxChunk], capped by
+// len(b) and by the bytes remaining, so only the final chunk may be shorter than minChunk.
+// The returned reader is not safe for concurrent use.
+// ChunkedReader panics unless total >= 0 and 1 <= minChunk <= maxChunk.
+func ChunkedReader(total, minChunk, maxChunk int) io.Reader {
+	if total < 0 || minChunk < 1 || maxChunk < minChunk {
+		panic("fcrand: ChunkedReader requires total >= 0 and 1 <= minChunk <= maxChunk")
+	}
+	remaining := total
+	return readerFunc(func(b []byte) (int, error) {
+		if remaining == 0 {
+			return 0, io.EOF
+		}
+		chunk := minChunk + int(uint64n(uint64(maxChunk-minChunk)+1))
+		n := min(chunk, len(b), remaining)
+		Read(b[:n])
+		remaining -= n
+		return n, nil
+	})
+}
@@ -0,0 +1,55 @@
+package fcrand
+
+import (
+	"io"
+	"testing"
+)
+
+// Test ChunkedReader serves exactly total bytes in varying chunk sizes
+func TestChunkedReader(t *testing.T) {
+	for _, total := range []int{0, 1, 100, 10000} {
+		b, err := io.ReadAll(ChunkedReader(total, 1, 64))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) != total {
+			t.Fatalf("ChunkedReader(%d) served %d bytes", total, len(b))
+		}
+		if total >= 100 {
+			assertHighEntropy(t, b)
+		}
+	}
+
+	const total, minChunk, maxChunk = 10000, 5, 50
+	r := ChunkedReader(total, minChunk, maxChunk)
+	buf := make([]byte, 100)
+	sizes := map[int]bool{}
+	read := 0
+	for {
+		n, err := r.Read(buf)
+		if err == io.EOF {
+			break
+		}
+		read += n
+		if (n < minChunk && read != total) || n > maxChunk {
+			t.Fatalf("Read returned chunk of %d bytes, want [%d, %d]", n, minChunk, maxChunk)
+		}
+		sizes[n] = true
+	}
+	if read != total {
+		t.Fatalf("ChunkedReader served %d bytes, want %d", read, total)
+	}
+	if len(sizes) < (maxChunk-minChunk)/2 {
+		t.Fatalf("ChunkedReader used only %d distinct chunk sizes", len(sizes))
+	}
+	if n, _ := ChunkedReader(10, 5, 5).Read(buf[:2]); n != 2 {
+		t.Fatalf("Read into a 2-byte buffer returned %d bytes", n)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ChunkedReader with minChunk > maxChunk did not panic")
+		}
+	}()
+	ChunkedReader(10, 5, 4)
+}
@@ -0,0 +1,20 @@
+package fcrand
+
+import "net/netip"
+
+const (
+	cidrMinPrefix = 8  // shortest generated prefix length
+	cidrMaxPrefix = 30 // longest generated prefix length, leaving at least 2 host bits
+)
+
+// CIDR returns a random IPv4 CIDR block such as "10.42.7.0/24", eg. for testing
+// network configuration parsers. The prefix length is uniform in [8, 30] and the address
+// is uniform over all IPv4 addresses with the host bits zeroed, so reserved and
+// public ranges may both appear.
+func CIDR() string {
+	var a [4]byte
+	Read(a[:])
+	bits := cidrMinPrefix + int(uint64n(cidrMaxPrefix-cidrMinPrefix+1))
+	p, _ := netip.AddrFrom4(a).Prefix(bits) // bits is valid for IPv4
+	return p.String()
+}
@@ -0,0 +1,32 @@
+package fcrand
+
+import (
+	"net"
+	"testing"
+)
+
+// Test CIDR parses as an IPv4 network with zero host bits and a prefix in range
+func TestCIDR(t *testing.T) {
+	prefixes := map[int]bool{}
+	for range 2000 {
+		s := CIDR()
+		ip, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			t.Fatalf("CIDR() = %q: %v", s, err)
+		}
+		if ip.To4() == nil {
+			t.Fatalf("CIDR() = %q is not IPv4", s)
+		}
+		if !ip.Equal(ipnet.IP) {
+			t.Fatalf("CIDR() = %q has nonzero host bits", s)
+		}
+		ones, bits := ipnet.Mask.Size()
+		if bits != 32 || ones < cidrMinPrefix || ones > cidrMaxPrefix {
+			t.Fatalf("CIDR() = %q has prefix length /%d", s, ones)
+		}
+		prefixes[ones] = true
+	}
+	if len(prefixes) != cidrMaxPrefix-cidrMinPrefix+1 {
+		t.Fatalf("CIDR produced %d distinct prefix lengths", len(prefixes))
+	}
+}
@@ -0,0 +1,22 @@
+package fcrand
+
+import (
+	"crypto/subtle"
+	"unsafe"
+)
+
+// Equal reports whether a and b are equal, in time that depends only on their lengths
+// and not on their contents. Use it instead of == when comparing generated secrets
+// (eg. tokens from Text) against untrusted input to avoid timing side channels.
+// Strings of differing lengths are never equal; only the lengths may leak via timing.
+func Equal(a, b string) bool {
+	return EqualBytes(
+		unsafe.Slice(unsafe.StringData(a), len(a)),
+		unsafe.Slice(unsafe.StringData(b), len(b)),
+	)
+}
+
+// EqualBytes is the []byte form of Equal.
+func EqualBytes(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
@@ -0,0 +1,26 @@
+package fcrand
+
+import "testing"
+
+// Test Equal and EqualBytes for equal, unequal and differing-length inputs
+func TestEqual(t *testing.T) {
+	token := Text()
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{token, token, true},
+		{token, token[:25] + "!", false},
+		{token, token[:25], false},
+		{"", "", true},
+		{"", "a", false},
+	}
+	for _, tc := range tests {
+		if got := Equal(tc.a, tc.b); got != tc.want {
+			t.Fatalf("Equal(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+		if got := EqualBytes([]byte(tc.a), []byte(tc.b)); got != tc.want {
+			t.Fatalf("EqualBytes(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package fcrand
+
+import (
+	"strconv"
+	"strings"
+)
+
+// cronFieldBounds holds the inclusive value range of each cron field:
+// minute, hour, day of month, month and day of week (0 is Sunday).
+var cronFieldBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+
+// cronMaxListLen is the maximum number of items in a generated cron list field.
+const cronMaxListLen = 4
+
+// CronExpr returns a random syntactically valid 5-field cron expression
+// ("minute hour day-of-month month day-of-week"), eg. "*/15 9-17 * 1,6 1-5",
+// for fuzzing cron parsers. Each field is independently one of "*", "*/step", a value,
+// a range "a-b", a stepped range "a-b/step" or a list of 2 to 4 values and ranges,
+// all within the field's bounds. Only numeric values are used, not names like "JAN".
+func CronExpr() string {
+	fields := make([]string, len(cronFieldBounds))
+	for i, b := range cronFieldBounds {
+		fields[i] = cronField(b[0], b[1])
+	}
+	return strings.Join(fields, " ")
+}
+
+// cronField returns a random cron field for values in [lo, hi].
+func cronField(lo, hi int) string {
+	switch uint64n(6) {
+	case 0:
+		return "*"
+	case 1:
+		return "*/" + strconv.Itoa(cronStep(lo, hi))
+	case 2:
+		return strconv.Itoa(cronValue(lo, hi))
+	case 3:
+		return cronRange(lo, hi)
+	case 4:
+		return cronRange(lo, hi) + "/" + strconv.Itoa(cronStep(lo, hi))
+	default:
+		items := make([]string, 2+uint64n(cronMaxListLen-1))
+		for i := range items {
+			if uint64n(2) == 0 {
+				items[i] = strconv.Itoa(cronValue(lo, hi))
+			} else {
+				items[i] = cronRange(lo, hi)
+			}
+		}
+		return strings.Join(items, ",")
+	}
+}
+
+// cronValue returns a uniform random value in [lo, hi].
+func cronValue(lo, hi int) int {
+	return lo + int(uint64n(uint64(hi-lo)+1))
+}
+
+// cronRange returns a random range "a-b" with lo <= a < b <= hi.
+func cronRange(lo, hi int) string {
+	a := cronValue(lo, hi-1)
+	b := cronValue(a+1, hi)
+	return strconv.Itoa(a) + "-" + strconv.Itoa(b)
+}
+
+// cronStep returns a uniform random step in [1, hi-lo].
+func cronStep(lo, hi int) int {
+	return cronValue(1, hi-lo)
+}
@@ -0,0 +1,71 @@
+package fcrand
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Test CronExpr has 5 fields, each with valid syntax and values within its bounds
+func TestCronExpr(t *testing.T) {
+	item := regexp.MustCompile(`^(\*|(\d+)(?:-(\d+))?)(?:/(\d+))?$`)
+	forms := map[string]bool{}
+	for range 2000 {
+		expr := CronExpr()
+		fields := strings.Split(expr, " ")
+		if len(fields) != 5 {
+			t.Fatalf("CronExpr() = %q has %d fields", expr, len(fields))
+		}
+		for i, field := range fields {
+			lo, hi := cronFieldBounds[i][0], cronFieldBounds[i][1]
+			items := strings.Split(field, ",")
+			if len(items) > cronMaxListLen {
+				t.Fatalf("CronExpr() = %q field %d has %d list items", expr, i, len(items))
+			}
+			for _, it := range items {
+				m := item.FindStringSubmatch(it)
+				if m == nil {
+					t.Fatalf("CronExpr() = %q field %d has invalid item %q", expr, i, it)
+				}
+				if len(items) > 1 && (m[1] == "*" || m[4] != "") {
+					t.Fatalf("CronExpr() = %q field %d has a list with item %q", expr, i, it)
+				}
+				a, b := lo, hi
+				if m[2] != "" {
+					a, _ = strconv.Atoi(m[2])
+					b = a
+				}
+				if m[3] != "" {
+					b, _ = strconv.Atoi(m[3])
+					if b <= a {
+						t.Fatalf("CronExpr() = %q field %d has empty range %q", expr, i, it)
+					}
+				}
+				if a < lo || b > hi {
+					t.Fatalf("CronExpr() = %q field %d item %q is out of [%d, %d]", expr, i, it, lo, hi)
+				}
+				if m[4] != "" {
+					if s, _ := strconv.Atoi(m[4]); s < 1 || s > hi-lo {
+						t.Fatalf("CronExpr() = %q field %d has step %d", expr, i, s)
+					}
+				}
+			}
+			switch {
+			case len(items) > 1:
+				forms["list"] = true
+			case strings.Contains(field, "/"):
+				forms["step"] = true
+			case strings.Contains(field, "-"):
+				forms["range"] = true
+			case field == "*":
+				forms["*"] = true
+			default:
+				forms["value"] = true
+			}
+		}
+	}
+	if len(forms) != 5 {
+		t.Fatalf("CronExpr produced only field forms %v", forms)
+	}
+}
@@ -0,0 +1,47 @@
+package fcrand
+
+import "strings"
+
+const (
+	csvFieldMaxLength = 12
+	csvSpecialChars   = ",\" " // comma, quote and space
+)
+
+// CSVRow returns a random CSV-encoded row (without a trailing newline) of cols fields,
+// eg. for fuzzing CSV parsers. Fields are 1 to 12 characters of letters and digits,
+// some containing commas, quotes or spaces. Fields with commas or quotes are always
+// quoted (with quotes doubled), and other fields are randomly quoted or not,
+// so the row exercises both forms. It panics if cols < 1.
+func CSVRow(cols int) string {
+	row, _ := csvRow(cols)
+	return row
+}
+
+// csvRow returns a random CSV row of cols fields together with the unencoded fields.
+func csvRow(cols int) (string, []string) {
+	if cols < 1 {
+		panic("fcrand: CSVRow requires cols >= 1")
+	}
+	fields := make([]string, cols)
+	var sb strings.Builder
+	for i := range fields {
+		alphabet := lowerAlphanumeric
+		if uint64n(2) == 0 {
+			alphabet += csvSpecialChars
+		}
+		f := randomString(alphabet, 1+int(uint64n(csvFieldMaxLength)))
+		fields[i] = f
+
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		if strings.ContainsAny(f, `,"`) || uint64n(2) == 0 {
+			sb.WriteByte('"')
+			sb.WriteString(strings.ReplaceAll(f, `"`, `""`))
+			sb.WriteByte('"')
+		} else {
+			sb.WriteString(f)
+		}
+	}
+	return sb.String(), fields
+}
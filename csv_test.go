@@ -0,0 +1,33 @@
+package fcrand
+
+import (
+	"encoding/csv"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Test CSV rows parse back into exactly the generated fields
+func TestCSVRow(t *testing.T) {
+	quoted, special := false, false
+	for _, cols := range []int{1, 2, 5, 20} {
+		for range 50 {
+			row, fields := csvRow(cols)
+			records, err := csv.NewReader(strings.NewReader(row)).ReadAll()
+			if err != nil {
+				t.Fatalf("csv parse of %q returned error: %v", row, err)
+			}
+			if len(records) != 1 || !slices.Equal(records[0], fields) {
+				t.Fatalf("csv parse of %q = %q, want %q", row, records, fields)
+			}
+			quoted = quoted || strings.Contains(row, `"`)
+			special = special || strings.ContainsAny(strings.Join(fields, ""), `,"`)
+		}
+	}
+	if !quoted || !special {
+		t.Fatalf("CSV rows never used quoting (%v) or special characters (%v)", quoted, special)
+	}
+	if r, err := csv.NewReader(strings.NewReader(CSVRow(3))).Read(); err != nil || len(r) != 3 {
+		t.Fatalf("CSVRow(3) parsed to %q, %v", r, err)
+	}
+}
@@ -0,0 +1,74 @@
+//go:build fcrand_debug
+
+package fcrand
+
+import "sync/atomic"
+
+// Instrumentation, compiled in only with the fcrand_debug build tag.
+var (
+	wasteRequested atomic.Int64 // total bytes requested from the cache
+	wasteConsumed  atomic.Int64 // total bytes consumed from the cache buffers
+	maxRequest     atomic.Int64 // largest single Read request size
+	cacheReads     atomic.Int64 // count of Read requests served from the cache
+	cacheRefills   atomic.Int64 // count of buffer refills from crypto/rand
+)
+
+// recordRequest records a Read request of n bytes.
+func recordRequest(n int) {
+	for {
+		cur := maxRequest.Load()
+		if int64(n) <= cur || maxRequest.CompareAndSwap(cur, int64(n)) {
+			return
+		}
+	}
+}
+
+// MaxRequestSeen returns the largest buffer size passed to Read so far.
+// A value above 512 means some requests bypassed the cache and went directly to crypto/rand.
+// MaxRequestSeen is only available with the fcrand_debug build tag.
+func MaxRequestSeen() int {
+	return int(maxRequest.Load())
+}
+
+// recordCacheRead records a cache-served request of requested bytes
+// which consumed consumed bytes from a buffer.
+func recordCacheRead(requested, consumed int) {
+	cacheReads.Add(1)
+	wasteRequested.Add(int64(requested))
+	wasteConsumed.Add(int64(consumed))
+}
+
+// recordRefill records a buffer refill from crypto/rand.
+func recordRefill() {
+	cacheRefills.Add(1)
+}
+
+// resetStats clears all counters.
+func resetStats() {
+	wasteRequested.Store(0)
+	wasteConsumed.Store(0)
+	maxRequest.Store(0)
+	cacheReads.Store(0)
+	cacheRefills.Store(0)
+}
+
+// WasteRatio returns the fraction of cache-consumed bytes that were discarded
+// due to large buffer block rounding, ie. (consumed-requested)/consumed.
+// Only requests served via the cache (1 to 512 bytes) are counted.
+// It returns 0 if no bytes have been consumed yet.
+// WasteRatio is only available with the fcrand_debug build tag.
+func WasteRatio() float64 {
+	consumed := wasteConsumed.Load()
+	if consumed == 0 {
+		return 0
+	}
+	return float64(consumed-wasteRequested.Load()) / float64(consumed)
+}
+
+// RefillsSaved returns an estimate of the crypto/rand calls avoided by the cache:
+// the number of Read requests served from the cache minus the number of buffer refills
+// they required. Requests which bypass the cache are not counted.
+// RefillsSaved is only available with the fcrand_debug build tag.
+func RefillsSaved() int64 {
+	return cacheReads.Load() - cacheRefills.Load()
+}
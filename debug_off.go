@@ -0,0 +1,12 @@
+//go:build !fcrand_debug
+
+package fcrand
+
+// recordCacheRead is a no-op without the fcrand_debug build tag.
+func recordCacheRead(requested, consumed int) {}
+
+// recordRefill is a no-op without the fcrand_debug build tag.
+func recordRefill() {}
+
+// recordRequest is a no-op without the fcrand_debug build tag.
+func recordRequest(n int) {}
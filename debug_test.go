@@ -0,0 +1,101 @@
+//go:build fcrand_debug
+
+package fcrand
+
+import (
+	"math"
+	"runtime"
+	"runtime/debug"
+	"testing"
+)
+
+// Test WasteRatio for a known request-size distribution
+func TestWasteRatio(t *testing.T) {
+	resetStats()
+	if r := WasteRatio(); r != 0 {
+		t.Fatalf("WasteRatio before any reads = %v, want 0", r)
+	}
+
+	small := make([]byte, 7)  // sb: consumes 7, wastes 0
+	large := make([]byte, 35) // lb: consumes 40, wastes 5
+	for range 100 {
+		Read(small)
+		Read(large)
+	}
+	Read(make([]byte, 1024)) // bypasses the cache, not counted
+
+	want := float64(100*5) / float64(100*7+100*40)
+	if r := WasteRatio(); math.Abs(r-want) > 1e-9 {
+		t.Fatalf("WasteRatio = %v, want %v", r, want)
+	}
+
+	resetStats()
+	Read(make([]byte, 33)) // worst case: 7/40
+	if r := WasteRatio(); math.Abs(r-7.0/40) > 1e-9 {
+		t.Fatalf("WasteRatio = %v, want %v", r, 7.0/40)
+	}
+}
+
+// Test MaxRequestSeen reports the largest request after mixed reads
+func TestMaxRequestSeen(t *testing.T) {
+	resetStats()
+	for _, n := range []int{16, 700, 0, 33, 512} {
+		Read(make([]byte, n))
+	}
+	if m := MaxRequestSeen(); m != 700 {
+		t.Fatalf("MaxRequestSeen = %d, want 700", m)
+	}
+	Read(make([]byte, 8))
+	if m := MaxRequestSeen(); m != 700 {
+		t.Fatalf("MaxRequestSeen after smaller read = %d, want 700", m)
+	}
+}
+
+// Test RefillsSaved arithmetic for a controlled read sequence
+func TestRefillsSaved(t *testing.T) {
+	if raceEnabled {
+		t.Skip("-race drops pooled caches at random, so the refill count is unpredictable")
+	}
+	defer debug.SetGCPercent(debug.SetGCPercent(-1)) // keep the pooled caches alive
+	resetStats()
+
+	// 31-byte reads use the small buffer: 33 reads per 1024-byte refill
+	const reads = 990
+	buf := make([]byte, 31)
+	for range reads {
+		Read(buf)
+	}
+	Read(make([]byte, 600)) // bypasses the cache, not counted
+
+	// A single fresh cache needs reads/33 = 30 refills, so RefillsSaved is 990 - 30.
+	// A cache already partly filled saves one more refill, and each extra cache used
+	// after the goroutine migrated between Ps costs at most one more.
+	const refills = reads / 33
+	saved := RefillsSaved()
+	if saved > reads-refills+1 || saved < reads-refills-int64(runtime.GOMAXPROCS(0)) {
+		t.Fatalf("RefillsSaved = %d, want about %d", saved, reads-refills)
+	}
+}
+
+// Test TryRead serves from the cache without ever refilling, and fails once drained
+func TestTryRead_NoRefill(t *testing.T) {
+	resetStats()
+	buf := make([]byte, 16)
+	served, failed := 0, 0
+	for range 64 * (runtime.GOMAXPROCS(0) + 1) { // more than every P's small buffer holds
+		if _, ok := TryRead(buf); ok {
+			served++
+		} else {
+			failed++
+		}
+	}
+	if refills := cacheRefills.Load(); refills != 0 {
+		t.Fatalf("TryRead caused %d refills, want 0", refills)
+	}
+	if reads := cacheReads.Load(); reads != int64(served) {
+		t.Fatalf("cache reads = %d, want %d served", reads, served)
+	}
+	if failed == 0 {
+		t.Fatal("TryRead never reported an insufficient buffer")
+	}
+}
@@ -15,6 +15,7 @@ package fcrand
 
 import (
 	cryptoRand "crypto/rand"
+	"encoding/base32"
 	"io"
 	"math/big"
 	"sync"
@@ -81,39 +82,83 @@ var Reader io.Reader = readerFunc(Read)
 // It never returns an error, and always fills b entirely.
 func Read(b []byte) (n int, err error) {
 	n = len(b)
+	recordRequest(n)
 
 	if n == 0 {
 		return 0, nil
 	}
 
 	if n > maxBytesToFillViaCache {
-		return cryptoRand.Read(b)
+		fill(b)
+		return n, nil
 	}
 
 	cachePtr := cachePool.Get().(*cache)
+	cachePtr.read(b, true)
+	cachePool.Put(cachePtr)
+	return n, nil
+}
 
+// TryRead fills b with cryptographically secure random bytes only if that can be done
+// without calling crypto/rand, ie. if the cache obtained from the pool already holds enough
+// bytes. Otherwise it leaves b untouched and returns (0, false), letting latency-critical
+// callers fall back to their own strategy instead of paying for a refill.
+// Since caches are pooled per P, a false result says nothing about other goroutines' caches.
+// Requests over 512 bytes are never served from the cache, so TryRead always fails for them.
+func TryRead(b []byte) (n int, ok bool) {
+	n = len(b)
+	if n == 0 {
+		return 0, true
+	}
+	if n > maxBytesToFillViaCache {
+		return 0, false
+	}
+	cachePtr := cachePool.Get().(*cache)
+	ok = cachePtr.read(b, false)
+	cachePool.Put(cachePtr)
+	if !ok {
+		return 0, false
+	}
+	return n, true
+}
+
+// read serves 0 < len(b) <= maxBytesToFillViaCache bytes from c: requests below sbCutoff
+// from the small buffer, and larger ones from the large buffer in whole blocks.
+// If the relevant buffer holds too few bytes, read refills it from crypto/rand when refill
+// is true, and otherwise returns false, leaving b and c untouched.
+func (c *cache) read(b []byte, refill bool) bool {
+	n := len(b)
 	if n < sbCutoff {
-		if n > cachePtr.sbCount {
-			cryptoRand.Read(cachePtr.sb)
-			cachePtr.sbCount = sbByteSize
-		}
-		copy(b, cachePtr.sb[sbByteSize-cachePtr.sbCount:])
-		cachePtr.sbCount -= n
-	} else {
-		if n > cachePtr.lbCount {
-			cryptoRand.Read(cachePtr.lb)
-			cachePtr.lbCount = lbByteSize
+		if n > c.sbCount {
+			if !refill {
+				return false
+			}
+			fill(c.sb)
+			recordRefill()
+			c.sbCount = sbByteSize
 		}
-		copy(b, cachePtr.lb[lbByteSize-cachePtr.lbCount:])
+		copy(b, c.sb[sbByteSize-c.sbCount:])
+		c.sbCount -= n
+		recordCacheRead(n, n)
+		return true
+	}
 
-		// Update lbBytesConsumed based on the number of blocks consumed.
-		// The ceiling division accounts for partial block consumption.
-		lbBytesConsumed := (n + lbBlockByteSize - 1) &^ (lbBlockByteSize - 1)
-		cachePtr.lbCount -= lbBytesConsumed
+	if n > c.lbCount {
+		if !refill {
+			return false
+		}
+		fill(c.lb)
+		recordRefill()
+		c.lbCount = lbByteSize
 	}
+	copy(b, c.lb[lbByteSize-c.lbCount:])
 
-	cachePool.Put(cachePtr)
-	return n, nil
+	// Update lbBytesConsumed based on the number of blocks consumed.
+	// The ceiling division accounts for partial block consumption.
+	lbBytesConsumed := (n + lbBlockByteSize - 1) &^ (lbBlockByteSize - 1)
+	c.lbCount -= lbBytesConsumed
+	recordCacheRead(n, lbBytesConsumed)
+	return true
 }
 
 // Prime returns a number of the given bit length that is prime with high probability.
@@ -122,31 +167,111 @@ func Prime(rand io.Reader, bits int) (*big.Int, error) {
 	return cryptoRand.Prime(rand, bits)
 }
 
+// PrimeN returns a number of the given bit length that is prime with high probability,
+// reading from Reader so that the many small reads made during generation are served
+// from the cache. PrimeN returns an error if bits < 2.
+// PrimeN is equivalent to Prime(Reader, bits).
+// Note that since Go 1.26 crypto/rand.Prime ignores its reader (see its documentation).
+func PrimeN(bits int) (*big.Int, error) {
+	return cryptoRand.Prime(Reader, bits)
+}
+
 // Int returns a uniform random value in [0, max). It panics if max <= 0, and
 // returns an error if rand.Read returns one.
 func Int(rand io.Reader, max *big.Int) (n *big.Int, err error) {
 	return cryptoRand.Int(rand, max)
 }
 
+// IntMax returns a uniform random value in [0, max), reading from Reader so that
+// the rejection sampling reads are served from the cache. It panics if max <= 0.
+// IntMax is equivalent to Int(Reader, max).
+func IntMax(max *big.Int) (*big.Int, error) {
+	return cryptoRand.Int(Reader, max)
+}
+
 // Text returns a cryptographically random string using the standard RFC 4648 base32 alphabet
 // for use when a secret string, token, password, or other text is needed.
 // The result contains at least 128 bits of randomness, enough to prevent brute force
 // guessing attacks and to make the likelihood of collisions vanishingly small.
 // A future version may return longer texts as needed to maintain those properties.
 func Text() string {
-	const (
-		base32 = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567" // Standard Base32 encoding alphabet from RFC 4648.
-		// base32_256 is the base32 repeated 8 times to cover all byte values (0-255).
-		base32_256 = base32 + base32 + base32 + base32 + base32 + base32 + base32 + base32
-		textLength = 26 // ⌈log₃₂ 2¹²⁸⌉ = 26 chars
-	)
+	return base32Text(textLength)
+}
+
+const textLength = 26 // ⌈log₃₂ 2¹²⁸⌉ = 26 chars
 
+// TextFrom is like Text, but draws its randomness from r instead of the package's cache,
+// eg. from a deterministic reader in tests. It reads with io.ReadFull semantics and returns
+// an error if r fails to provide enough bytes.
+func TextFrom(r io.Reader) (string, error) {
 	src := make([]byte, textLength)
+	if _, err := io.ReadFull(r, src); err != nil {
+		return "", err
+	}
+	return base32Map(src), nil
+}
+
+// Token returns a cryptographically random string using the standard RFC 4648 base32 alphabet
+// containing at least the given number of bits of randomness.
+// Each character carries 5 bits, so the result is ⌈bits/5⌉ characters long.
+// Token(128) is equivalent to Text(). It panics if bits <= 0.
+func Token(bits int) string {
+	if bits <= 0 {
+		panic("fcrand: Token bits must be positive")
+	}
+	return base32Text((bits + 4) / 5)
+}
+
+// TokenBytes returns the unpadded RFC 4648 base32 encoding of exactly nbytes random bytes,
+// ⌈8*nbytes/5⌉ characters long, for callers that budget entropy in bytes rather than bits.
+// Unlike Token, the result decodes back to the random bytes. It panics if nbytes <= 0.
+func TokenBytes(nbytes int) string {
+	if nbytes <= 0 {
+		panic("fcrand: TokenBytes nbytes must be positive")
+	}
+	b := make([]byte, nbytes)
+	Read(b)
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)
+}
+
+// OTPSecret returns a random 160-bit (20-byte) HOTP/TOTP shared secret encoded as
+// 32 characters of unpadded uppercase RFC 4648 base32, the format expected by
+// authenticator apps.
+func OTPSecret() string {
+	const otpSecretLength = 32 // 160 bits / 5 bits per char
+	return base32Text(otpSecretLength)
+}
+
+// Base32Padded returns the standard RFC 4648 base32 encoding of nbytes random bytes,
+// with '=' padding to a multiple of 8 characters, for systems that expect padded base32.
+// Unlike Text, the result decodes back to exactly nbytes bytes. It panics if nbytes < 0.
+func Base32Padded(nbytes int) string {
+	b := make([]byte, nbytes)
+	Read(b)
+	return base32.StdEncoding.EncodeToString(b)
+}
+
+const (
+	base32Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567" // Standard Base32 encoding alphabet from RFC 4648.
+	// base32_256 is the base32Alphabet repeated 8 times to cover all byte values (0-255).
+	base32_256 = base32Alphabet + base32Alphabet + base32Alphabet + base32Alphabet +
+		base32Alphabet + base32Alphabet + base32Alphabet + base32Alphabet
+)
+
+// base32Text returns a random base32 string of n > 0 characters, 5 bits of randomness each.
+func base32Text(n int) string {
+	src := make([]byte, n)
 	Read(src) // guaranteed not to fail since Go 1.24
+	return base32Map(src)
+}
+
+// base32Map maps each byte of non-empty src in place to a base32 character,
+// keeping its low 5 bits, and returns src as a string. src must not be used afterwards.
+func base32Map(src []byte) string {
 	for i := range src {
 		src[i] = base32_256[src[i]]
 	}
-	return unsafe.String(&src[0], textLength)
+	return unsafe.String(&src[0], len(src))
 }
 
 // cache holds a pair of pre-filled random buffers reused across Read calls via cachePool.
@@ -3,6 +3,8 @@ package fcrand
 import (
 	gorand "crypto/rand"
 	"fmt"
+	"io"
+	"math/big"
 	"strconv"
 	"testing"
 )
@@ -114,3 +116,122 @@ func Benchmark_gorand_Concur(b *testing.B) {
 		}
 	}
 }
+
+func Benchmark_ReadNonZero(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 256)
+	b.SetBytes(int64(len(buf)))
+	for b.Loop() {
+		ReadNonZero(buf)
+	}
+}
+
+func Benchmark_ReadNonZero_PerByte(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 256)
+	b.SetBytes(int64(len(buf)))
+	for b.Loop() {
+		for i := range buf {
+			for {
+				Read(buf[i : i+1])
+				if buf[i] != 0 {
+					break
+				}
+			}
+		}
+	}
+}
+
+func Benchmark_fcrand_IntMax(b *testing.B) {
+	b.ReportAllocs()
+	max := big.NewInt(1000)
+	for b.Loop() {
+		if _, err := IntMax(max); err != nil {
+			b.Fatalf("IntMax failed: %v", err)
+		}
+	}
+}
+
+func Benchmark_gorand_Int(b *testing.B) {
+	b.ReportAllocs()
+	max := big.NewInt(1000)
+	for b.Loop() {
+		if _, err := Int(gorand.Reader, max); err != nil {
+			b.Fatalf("Int failed: %v", err)
+		}
+	}
+}
+
+func Benchmark_ReadUint64s(b *testing.B) {
+	b.ReportAllocs()
+	dst := make([]uint64, 32)
+	b.SetBytes(int64(len(dst) * 8))
+	for b.Loop() {
+		ReadUint64s(dst)
+	}
+}
+
+func Benchmark_ReadUint64s_Loop(b *testing.B) {
+	b.ReportAllocs()
+	dst := make([]uint64, 32)
+	b.SetBytes(int64(len(dst) * 8))
+	for b.Loop() {
+		for i := range dst {
+			dst[i] = randUint64()
+		}
+	}
+}
+
+func Benchmark_ByteSampler(b *testing.B) {
+	b.ReportAllocs()
+	var freq [256]uint32
+	for i := range freq {
+		freq[i] = uint32(i + 1)
+	}
+	s := NewByteSampler(freq)
+	buf := make([]byte, 64)
+	b.SetBytes(int64(len(buf)))
+	for b.Loop() {
+		s.Read(buf)
+	}
+}
+
+func Benchmark_ReadBiased(b *testing.B) {
+	b.ReportAllocs()
+	var freq [256]uint32
+	for i := range freq {
+		freq[i] = uint32(i + 1)
+	}
+	buf := make([]byte, 64)
+	b.SetBytes(int64(len(buf)))
+	for b.Loop() {
+		ReadBiased(buf, freq)
+	}
+}
+
+// Benchmark_PoolOverhead measures a bare cachePool Get/Put cycle, without any copying
+// or refills, to separate pool management cost from the rest of Read.
+func Benchmark_PoolOverhead(b *testing.B) {
+	b.Run("Serial", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			cachePool.Put(cachePool.Get())
+		}
+	})
+	b.Run(fmt.Sprintf("Concur_G%d", _goroutineCounts[0]), func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetParallelism(_goroutineCounts[0])
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				cachePool.Put(cachePool.Get())
+			}
+		})
+	})
+}
+
+func Benchmark_RandomLines(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		RandomLines(io.Discard, 100, 80)
+	}
+}
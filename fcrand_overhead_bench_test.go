@@ -0,0 +1,23 @@
+//go:build fcrand_bench
+
+package fcrand
+
+import (
+	"strconv"
+	"testing"
+)
+
+// Benchmark_fcrand_Overhead measures the buffering overhead of Read alone.
+// Run with: go test -tags fcrand_bench -run ^$ -bench Overhead
+func Benchmark_fcrand_Overhead(b *testing.B) {
+	b.ReportAllocs()
+	for _, size := range _sizes {
+		buf := make([]byte, size)
+		b.Run("Size_"+strconv.Itoa(size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for b.Loop() {
+				Read(buf)
+			}
+		})
+	}
+}
@@ -3,9 +3,14 @@ package fcrand
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/base32"
+	"io"
+	"math"
 	"math/big"
+	mathrand "math/rand/v2"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 // Test that Reader.Read calls Read internally
@@ -48,9 +53,7 @@ func TestRead_MediumBuffer(t *testing.T) {
 	if n != 128 {
 		t.Fatalf("Read returned n=%d, want 128", n)
 	}
-	if bytes.Equal(buf, make([]byte, 128)) {
-		t.Fatal("Read returned all zero bytes")
-	}
+	assertHighEntropy(t, buf)
 }
 
 // Test Read for large buffers (>512, falls back to crypto/rand)
@@ -63,11 +66,56 @@ func TestRead_LargeBuffer(t *testing.T) {
 	if n != 1024 {
 		t.Fatalf("Read returned n=%d, want 1024", n)
 	}
-	if bytes.Equal(buf, make([]byte, 1024)) {
-		t.Fatal("Read returned all zero bytes")
+	assertHighEntropy(t, buf)
+}
+
+// assertHighEntropy fails tb if the Shannon entropy estimate of b (in bits per byte) is
+// implausibly low for random data. A buffer of n random bytes has at most
+// log₂(min(n, 256)) bits per byte of observable entropy; the threshold is 1.5 bits below that.
+// For random buffers of 16 to 1024 bytes the estimate lies more than 10 standard deviations
+// above the threshold, so the false-positive probability is negligible.
+func assertHighEntropy(tb testing.TB, b []byte) {
+	tb.Helper()
+	var counts [256]int
+	for _, c := range b {
+		counts[c]++
+	}
+	entropy := 0.0
+	for _, c := range counts {
+		if c > 0 {
+			p := float64(c) / float64(len(b))
+			entropy -= p * math.Log2(p)
+		}
+	}
+	if threshold := math.Log2(float64(min(len(b), 256))) - 1.5; entropy < threshold {
+		tb.Fatalf("entropy estimate %.3f bits/byte for %d bytes, want >= %.3f", entropy, len(b), threshold)
+	}
+}
+
+// Test assertHighEntropy rejects low-entropy data
+func TestAssertHighEntropy(t *testing.T) {
+	ft := &fakeTB{TB: t}
+	func() {
+		defer func() { recover() }() // fakeTB.Fatalf panics to stop the helper
+		assertHighEntropy(ft, bytes.Repeat([]byte("abcd"), 64))
+	}()
+	if !ft.failed {
+		t.Fatal("assertHighEntropy accepted 2 bits/byte data")
 	}
 }
 
+// fakeTB records failures instead of failing the test
+type fakeTB struct {
+	testing.TB
+	failed bool
+}
+
+func (f *fakeTB) Helper() {}
+func (f *fakeTB) Fatalf(format string, args ...any) {
+	f.failed = true
+	panic("fakeTB.Fatalf")
+}
+
 // Test Read with zero-length buffer
 func TestRead_ZeroLength(t *testing.T) {
 	n, err := Read([]byte{})
@@ -90,6 +138,26 @@ func TestText(t *testing.T) {
 	}
 }
 
+// Test TextFrom is deterministic for a deterministic reader
+func TestTextFrom(t *testing.T) {
+	seed := [32]byte{1, 2, 3}
+	s1, err1 := TextFrom(iotest.HalfReader(mathrand.NewChaCha8(seed)))
+	s2, err2 := TextFrom(mathrand.NewChaCha8(seed))
+	if err1 != nil || err2 != nil {
+		t.Fatalf("TextFrom returned errors: %v, %v", err1, err2)
+	}
+	if s1 != s2 {
+		t.Fatalf("TextFrom with the same seed returned %q and %q", s1, s2)
+	}
+	if len(s1) != 26 || !isBase32(s1) {
+		t.Fatalf("TextFrom returned invalid text %q", s1)
+	}
+
+	if _, err := TextFrom(bytes.NewReader(make([]byte, 10))); err != io.ErrUnexpectedEOF {
+		t.Fatalf("TextFrom on a short reader returned err=%v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
 func isBase32(s string) bool {
 	for _, r := range s {
 		if !strings.ContainsRune("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567", r) {
@@ -110,6 +178,25 @@ func TestPrime(t *testing.T) {
 	}
 }
 
+// Test PrimeN returns a probable prime of the requested bit length
+func TestPrimeN(t *testing.T) {
+	for _, bits := range []int{2, 17, 128, 256} {
+		prime, err := PrimeN(bits)
+		if err != nil {
+			t.Fatalf("PrimeN(%d) returned error: %v", bits, err)
+		}
+		if prime.BitLen() != bits {
+			t.Fatalf("PrimeN(%d) returned value with bit length %d", bits, prime.BitLen())
+		}
+		if !prime.ProbablyPrime(20) {
+			t.Fatalf("PrimeN(%d) returned composite %v", bits, prime)
+		}
+	}
+	if _, err := PrimeN(1); err == nil {
+		t.Fatal("PrimeN(1) returned no error")
+	}
+}
+
 // Test Int returns a valid random int < max
 func TestInt(t *testing.T) {
 	max := big.NewInt(1 << 62)
@@ -122,6 +209,119 @@ func TestInt(t *testing.T) {
 	}
 }
 
+// Test IntMax stays within [0, max)
+func TestIntMax(t *testing.T) {
+	for _, max := range []*big.Int{big.NewInt(1), big.NewInt(6), big.NewInt(1 << 62), new(big.Int).Lsh(big.NewInt(1), 300)} {
+		for range 100 {
+			n, err := IntMax(max)
+			if err != nil {
+				t.Fatalf("IntMax returned error: %v", err)
+			}
+			if n.Sign() < 0 || n.Cmp(max) >= 0 {
+				t.Fatalf("IntMax returned %v, expected in [0, %v)", n, max)
+			}
+		}
+	}
+}
+
+// Test TryRead on the shared pool
+func TestTryRead(t *testing.T) {
+	if n, ok := TryRead(nil); n != 0 || !ok {
+		t.Fatalf("TryRead(nil) = %d, %v", n, ok)
+	}
+	if n, ok := TryRead(make([]byte, 513)); n != 0 || ok {
+		t.Fatalf("TryRead(513 bytes) = %d, %v, want 0, false", n, ok)
+	}
+
+	// A small read right after warming the cache is served from it. Pooled caches are
+	// per P and -race drops pooled caches at random, so allow some misses.
+	buf := make([]byte, 16)
+	served := 0
+	for range 100 {
+		Read(buf)
+		if n, ok := TryRead(buf); ok {
+			if n != len(buf) {
+				t.Fatalf("TryRead returned n=%d, ok=true", n)
+			}
+			served++
+		} else if n != 0 {
+			t.Fatalf("TryRead returned n=%d, ok=false", n)
+		}
+	}
+	if served < 50 {
+		t.Fatalf("TryRead served %d of 100 reads from a warm cache", served)
+	}
+
+	// A request larger than the remaining small buffer returns (0, false) without a refill.
+	// Retry until TryRead is observed to have used the prepared cache.
+	for range 100 {
+		c := cachePool.Get().(*cache)
+		clear(c.sb)
+		c.sbCount = 10
+		cachePool.Put(c)
+		clear(buf)
+		n, ok := TryRead(buf)
+		c2 := cachePool.Get().(*cache)
+		cachePool.Put(c2)
+		if c2 != c {
+			continue
+		}
+		sbCount, sbZero := c.sbCount, bytes.Equal(c.sb, make([]byte, sbByteSize))
+		c.sbCount = 0 // never serve the zeroed buffer to other reads
+		if n != 0 || ok || !bytes.Equal(buf, make([]byte, 16)) {
+			t.Fatalf("TryRead(16 bytes) with 10 buffered = %d, %v, buf=%x", n, ok, buf)
+		}
+		if sbCount != 10 || !sbZero {
+			t.Fatalf("TryRead changed or refilled the cache, sbCount=%d", sbCount)
+		}
+		return
+	}
+	t.Fatal("could not observe TryRead on a prepared cache")
+}
+
+// Test cache.read with refill false serves buffered bytes without refilling, and fails
+// leaving b untouched exactly when the buffer holds too few bytes
+func TestCache_read_NoRefill(t *testing.T) {
+	c := cachePool.New().(*cache)
+	for i := range c.sb {
+		c.sb[i] = byte(i)
+	}
+	for i := range c.lb {
+		c.lb[i] = byte(i * 7)
+	}
+	sb, lb := bytes.Clone(c.sb), bytes.Clone(c.lb)
+
+	b := make([]byte, 16)
+	if c.read(b, false) || !bytes.Equal(b, make([]byte, 16)) {
+		t.Fatal("read on an empty cache succeeded or wrote to b")
+	}
+
+	c.sbCount = 20
+	if !c.read(b, false) || !bytes.Equal(b, sb[sbByteSize-20:sbByteSize-4]) || c.sbCount != 4 {
+		t.Fatalf("read(16 bytes) with 20 buffered: b=%x, sbCount=%d", b, c.sbCount)
+	}
+	clear(b)
+	if c.read(b, false) || !bytes.Equal(b, make([]byte, 16)) || c.sbCount != 4 {
+		t.Fatalf("read(16 bytes) with 4 buffered succeeded or changed state, sbCount=%d", c.sbCount)
+	}
+	if !c.read(b[:4], false) || c.sbCount != 0 {
+		t.Fatalf("read(4 bytes) with 4 buffered failed, sbCount=%d", c.sbCount)
+	}
+
+	c.lbCount = 40
+	large := make([]byte, 33) // consumes 5 blocks = 40 bytes
+	if !c.read(large, false) || !bytes.Equal(large, lb[lbByteSize-40:lbByteSize-7]) || c.lbCount != 0 {
+		t.Fatalf("read(33 bytes) with 40 buffered: lbCount=%d", c.lbCount)
+	}
+	if c.read(make([]byte, 32), false) {
+		t.Fatal("read(32 bytes) on an empty large buffer succeeded")
+	}
+
+	if !bytes.Equal(c.sb, sb) || !bytes.Equal(c.lb, lb) {
+		t.Fatal("read refilled a buffer")
+	}
+}
+
 // Coverage test for cachePool.New
 func TestCachePool_New(t *testing.T) {
 	c := cachePool.New().(*cache)
@@ -132,3 +332,85 @@ func TestCachePool_New(t *testing.T) {
 		t.Fatalf("Expected sb size %d, got %d", sbByteSize, len(c.sb))
 	}
 }
+
+// Test Token returns ⌈bits/5⌉ base32 chars
+func TestToken(t *testing.T) {
+	for _, tc := range []struct{ bits, want int }{{1, 1}, {5, 1}, {6, 2}, {64, 13}, {128, 26}, {256, 52}} {
+		s := Token(tc.bits)
+		if len(s) != tc.want {
+			t.Fatalf("Token(%d) returned string of length %d, want %d", tc.bits, len(s), tc.want)
+		}
+		if !isBase32(s) {
+			t.Fatalf("Token(%d) returned string with invalid characters: %s", tc.bits, s)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Token(0) did not panic")
+		}
+	}()
+	Token(0)
+}
+
+// Test TokenBytes length formula and that it decodes to exactly nbytes
+func TestTokenBytes(t *testing.T) {
+	for _, nbytes := range []int{1, 2, 4, 5, 16, 20, 32} {
+		s := TokenBytes(nbytes)
+		if want := (8*nbytes + 4) / 5; len(s) != want {
+			t.Fatalf("TokenBytes(%d) returned string of length %d, want %d", nbytes, len(s), want)
+		}
+		if !isBase32(s) {
+			t.Fatalf("TokenBytes(%d) returned string with invalid characters: %s", nbytes, s)
+		}
+		b, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s)
+		if err != nil {
+			t.Fatalf("TokenBytes(%d) returned invalid base32 %q: %v", nbytes, s, err)
+		}
+		if len(b) != nbytes {
+			t.Fatalf("TokenBytes(%d) decoded to %d bytes", nbytes, len(b))
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("TokenBytes(0) did not panic")
+		}
+	}()
+	TokenBytes(0)
+}
+
+// Test OTPSecret decodes to exactly 20 bytes
+func TestOTPSecret(t *testing.T) {
+	s := OTPSecret()
+	if !isBase32(s) {
+		t.Fatalf("OTPSecret returned string with invalid characters: %s", s)
+	}
+	secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s)
+	if err != nil {
+		t.Fatalf("OTPSecret returned invalid base32 %q: %v", s, err)
+	}
+	if len(secret) != 20 {
+		t.Fatalf("OTPSecret decoded to %d bytes, want 20", len(secret))
+	}
+}
+
+// Test Base32Padded is padded to a multiple of 8 and decodes to exactly nbytes
+func TestBase32Padded(t *testing.T) {
+	for nbytes := range 12 {
+		s := Base32Padded(nbytes)
+		if len(s)%8 != 0 {
+			t.Fatalf("Base32Padded(%d) = %q is not padded to a multiple of 8", nbytes, s)
+		}
+		b, err := base32.StdEncoding.DecodeString(s)
+		if err != nil {
+			t.Fatalf("Base32Padded(%d) returned invalid base32 %q: %v", nbytes, s, err)
+		}
+		if len(b) != nbytes {
+			t.Fatalf("Base32Padded(%d) decoded to %d bytes", nbytes, len(b))
+		}
+	}
+	if s := Base32Padded(1); !strings.HasSuffix(s, "======") {
+		t.Fatalf("Base32Padded(1) = %q, want 6 padding characters", s)
+	}
+}
@@ -0,0 +1,194 @@
+package fcrand
+
+import (
+	"errors"
+	"reflect"
+)
+
+var errFillKeysTarget = errors.New("fcrand: FillKeys requires a non-nil pointer to a struct")
+
+// FillKeys fills every exported [N]byte array field of the struct pointed to by v
+// with cryptographically secure random bytes, eg. the keys, IVs and nonces of a
+// crypto configuration struct. Exported nested struct fields are walked recursively.
+// All other fields, and all unexported fields, are left untouched.
+// It returns an error if v is not a non-nil pointer to a struct.
+func FillKeys(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errFillKeysTarget
+	}
+	fillKeys(rv.Elem())
+	return nil
+}
+
+// fillKeys fills the exported byte array fields of the addressable struct value s.
+func fillKeys(s reflect.Value) {
+	t := s.Type()
+	for i := range t.NumField() {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		f := s.Field(i)
+		switch {
+		case f.Kind() == reflect.Array && f.Type().Elem().Kind() == reflect.Uint8:
+			Read(f.Bytes())
+		case f.Kind() == reflect.Struct:
+			fillKeys(f)
+		}
+	}
+}
+
+// randValueMaxStringLen is the maximum length of a string generated by RandValue.
+const randValueMaxStringLen = 16
+
+var errRandValueKind = errors.New("fcrand: RandValue supports only bool, integer, float and string kinds")
+
+// RandValue returns a random value of the Go type named by the primitive kind, eg. an int
+// for reflect.Int, for use as a leaf generator in property-based tests.
+// Integers are uniform over their type's full range, floats are uniform in [0, 1),
+// and strings hold 0 to 16 lowercase alphanumeric characters.
+// It returns an error for non-primitive kinds.
+func RandValue(kind reflect.Kind) (any, error) {
+	u := randUint64()
+	switch kind {
+	case reflect.Bool:
+		return u&1 == 1, nil
+	case reflect.Int:
+		return int(u), nil
+	case reflect.Int8:
+		return int8(u), nil
+	case reflect.Int16:
+		return int16(u), nil
+	case reflect.Int32:
+		return int32(u), nil
+	case reflect.Int64:
+		return int64(u), nil
+	case reflect.Uint:
+		return uint(u), nil
+	case reflect.Uint8:
+		return uint8(u), nil
+	case reflect.Uint16:
+		return uint16(u), nil
+	case reflect.Uint32:
+		return uint32(u), nil
+	case reflect.Uint64:
+		return u, nil
+	case reflect.Float32:
+		return float32(u>>40) / (1 << 24), nil
+	case reflect.Float64:
+		return float64(u>>11) / (1 << 53), nil
+	case reflect.String:
+		return randomString(lowerAlphanumeric, int(u%(randValueMaxStringLen+1))), nil
+	default:
+		return nil, errRandValueKind
+	}
+}
+
+const (
+	randomArgsMaxLen   = 4 // max length of slices and maps generated by RandomArgs
+	randomArgsMaxDepth = 4 // nesting depth beyond which RandomArgs generates nil pointers and empty slices and maps
+)
+
+var (
+	errRandomArgsType = errors.New("fcrand: RandomArgs requires a func type")
+	errRandomArgsKind = errors.New("fcrand: RandomArgs supports only bool, integer, float, string, array, slice, map, pointer and struct parameters")
+)
+
+// RandomArgs returns random arguments for a function of type fnType, one per parameter,
+// for reflection-based fuzzing via reflect.Value.Call (or CallSlice for a variadic
+// function, whose final argument is a slice). Primitive values come from RandValue and are
+// converted to named types; arrays, slices, maps, pointers and exported struct fields are
+// filled recursively, with slices and maps of 0 to 4 elements and nil pointers, empty
+// slices and empty maps past 4 levels of nesting. Unexported struct fields are left zero.
+// It returns an error if fnType is not a func type or a parameter contains another kind,
+// such as a channel, func or interface.
+func RandomArgs(fnType reflect.Type) ([]reflect.Value, error) {
+	if fnType == nil || fnType.Kind() != reflect.Func {
+		return nil, errRandomArgsType
+	}
+	args := make([]reflect.Value, fnType.NumIn())
+	for i := range args {
+		if !randomArgsSupported(fnType.In(i), map[reflect.Type]bool{}) {
+			return nil, errRandomArgsKind
+		}
+	}
+	for i := range args {
+		args[i] = randomValueOf(fnType.In(i), 0)
+	}
+	return args, nil
+}
+
+// randomArgsSupported reports whether randomValueOf can generate every value of type t,
+// checking element, key and field types even where generated values may not contain them.
+// seen holds the composite types already being checked, to terminate on recursive types.
+func randomArgsSupported(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return true
+	}
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Pointer:
+		return randomArgsSupported(t.Elem(), seen)
+	case reflect.Map:
+		return randomArgsSupported(t.Key(), seen) && randomArgsSupported(t.Elem(), seen)
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if t.Field(i).IsExported() && !randomArgsSupported(t.Field(i).Type, seen) {
+				return false
+			}
+		}
+		return true
+	default:
+		_, err := RandValue(t.Kind())
+		return err == nil
+	}
+}
+
+// randomValueOf returns a random value of the supported type t nested depth levels deep.
+func randomValueOf(t reflect.Type, depth int) reflect.Value {
+	n := 0 // length of a generated slice or map
+	if depth < randomArgsMaxDepth {
+		n = int(uint64n(randomArgsMaxLen + 1))
+	}
+	switch t.Kind() {
+	case reflect.Array, reflect.Slice:
+		var v reflect.Value
+		if t.Kind() == reflect.Slice {
+			v = reflect.MakeSlice(t, n, n)
+		} else {
+			v = reflect.New(t).Elem() // addressable, for Bytes
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			Read(v.Bytes())
+			return v
+		}
+		for i := range v.Len() {
+			v.Index(i).Set(randomValueOf(t.Elem(), depth+1))
+		}
+		return v
+	case reflect.Map:
+		v := reflect.MakeMapWithSize(t, n)
+		for range n {
+			v.SetMapIndex(randomValueOf(t.Key(), depth+1), randomValueOf(t.Elem(), depth+1))
+		}
+		return v
+	case reflect.Pointer:
+		if depth >= randomArgsMaxDepth {
+			return reflect.Zero(t)
+		}
+		p := reflect.New(t.Elem())
+		p.Elem().Set(randomValueOf(t.Elem(), depth+1))
+		return p
+	case reflect.Struct:
+		v := reflect.New(t).Elem()
+		for i := range t.NumField() {
+			if t.Field(i).IsExported() {
+				v.Field(i).Set(randomValueOf(t.Field(i).Type, depth+1))
+			}
+		}
+		return v
+	default:
+		x, _ := RandValue(t.Kind()) // t is supported
+		return reflect.ValueOf(x).Convert(t)
+	}
+}
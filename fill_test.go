@@ -0,0 +1,143 @@
+package fcrand
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// Test FillKeys fills only exported byte array fields
+func TestFillKeys(t *testing.T) {
+	type nested struct {
+		Nonce [12]byte
+	}
+	type config struct {
+		Key     [32]byte
+		IV      [12]byte
+		Name    string
+		Counter int
+		Bytes   []byte
+		Words   [4]uint16
+		secret  [16]byte
+		Inner   nested
+		Ptr     *nested
+	}
+	var c config
+	if err := FillKeys(&c); err != nil {
+		t.Fatalf("FillKeys returned error: %v", err)
+	}
+	for name, b := range map[string][]byte{"Key": c.Key[:], "IV": c.IV[:], "Inner.Nonce": c.Inner.Nonce[:]} {
+		if bytes.Equal(b, make([]byte, len(b))) {
+			t.Fatalf("field %s was not filled", name)
+		}
+	}
+	if c.Name != "" || c.Counter != 0 || c.Bytes != nil || c.Words != [4]uint16{} || c.secret != [16]byte{} || c.Ptr != nil {
+		t.Fatalf("FillKeys modified other fields: %+v", c)
+	}
+
+	for _, v := range []any{c, (*config)(nil), new(int), nil} {
+		if err := FillKeys(v); err == nil {
+			t.Fatalf("FillKeys(%T) returned no error", v)
+		}
+	}
+}
+
+// Test RandValue returns values of the requested Go type
+func TestRandValue(t *testing.T) {
+	kinds := []reflect.Kind{
+		reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String,
+	}
+	for _, kind := range kinds {
+		v, err := RandValue(kind)
+		if err != nil {
+			t.Fatalf("RandValue(%v) returned error: %v", kind, err)
+		}
+		if got := reflect.TypeOf(v).Kind(); got != kind || reflect.TypeOf(v).PkgPath() != "" {
+			t.Fatalf("RandValue(%v) returned %T", kind, v)
+		}
+	}
+	for range 100 {
+		v, _ := RandValue(reflect.Float64)
+		if f := v.(float64); f < 0 || f >= 1 {
+			t.Fatalf("RandValue(Float64) = %v", f)
+		}
+		v, _ = RandValue(reflect.String)
+		if s := v.(string); len(s) > randValueMaxStringLen {
+			t.Fatalf("RandValue(String) = %q", s)
+		}
+	}
+
+	for _, kind := range []reflect.Kind{reflect.Invalid, reflect.Struct, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.Pointer, reflect.Complex128} {
+		if _, err := RandValue(kind); err == nil {
+			t.Fatalf("RandValue(%v) returned no error", kind)
+		}
+	}
+}
+
+// Test RandomArgs argument types match the parameters and unsupported kinds are rejected
+func TestRandomArgs(t *testing.T) {
+	type level int8
+	type node struct {
+		Next *node
+	}
+	type config struct {
+		Name   string
+		Port   uint16
+		Key    [16]byte
+		Tags   []string
+		Limits map[string]float64
+		Level  *level
+		List   node
+		hidden int
+	}
+	fn := func(n int, s string, c config, ids ...int64) int {
+		return n + len(s) + len(c.Tags) + len(ids)
+	}
+	fnType := reflect.TypeOf(fn)
+
+	for range 100 {
+		args, err := RandomArgs(fnType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(args) != fnType.NumIn() {
+			t.Fatalf("RandomArgs returned %d arguments, want %d", len(args), fnType.NumIn())
+		}
+		for i, a := range args {
+			if a.Type() != fnType.In(i) {
+				t.Fatalf("argument %d has type %v, want %v", i, a.Type(), fnType.In(i))
+			}
+		}
+		c := args[2].Interface().(config)
+		if len(c.Tags) > randomArgsMaxLen || len(c.Limits) > randomArgsMaxLen || c.Level == nil || c.hidden != 0 {
+			t.Fatalf("RandomArgs generated unexpected config %+v", c)
+		}
+		reflect.ValueOf(fn).CallSlice(args)
+	}
+
+	args, _ := RandomArgs(reflect.TypeOf(func(k [32]byte) {}))
+	if k := args[0].Interface().([32]byte); k == [32]byte{} {
+		t.Fatal("RandomArgs left a byte array zero")
+	}
+	if args, err := RandomArgs(reflect.TypeOf(func() {})); err != nil || len(args) != 0 {
+		t.Fatalf("RandomArgs(func()) = %v, %v", args, err)
+	}
+
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf(func(chan int) {}),
+		reflect.TypeOf(func(func()) {}),
+		reflect.TypeOf(func(error) {}),
+		reflect.TypeOf(func(struct{ F []func() }) {}),
+	} {
+		if _, err := RandomArgs(typ); err != errRandomArgsKind {
+			t.Fatalf("RandomArgs(%v) error = %v, want %v", typ, err, errRandomArgsKind)
+		}
+	}
+	for _, typ := range []reflect.Type{nil, reflect.TypeOf(0)} {
+		if _, err := RandomArgs(typ); err != errRandomArgsType {
+			t.Fatalf("RandomArgs(%v) error = %v, want %v", typ, err, errRandomArgsType)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package fcrand
+
+import (
+	"math"
+	"math/big"
+)
+
+// FloatRange returns a uniform random float64 in [min, max). Negative bounds are allowed.
+// It panics unless min and max are finite and max > min.
+func FloatRange(min, max float64) float64 {
+	if !(max > min) || math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("fcrand: FloatRange requires finite bounds with max > min")
+	}
+	u := float64Unit()
+	r := min + u*(max-min)
+	if math.IsInf(max-min, 0) { // range wider than MaxFloat64
+		r = min*(1-u) + max*u
+	}
+	if r >= max { // rounding can reach max
+		r = math.Nextafter(max, min)
+	}
+	return r
+}
+
+// LaplaceNoise returns a sample from the Laplace distribution with mean 0 and the given
+// scale, the standard noise mechanism for ε-differential privacy (scale = sensitivity/ε).
+// It uses the inverse-CDF method over a crypto-secure uniform in (-0.5, 0.5).
+// It panics if scale is not positive and finite.
+func LaplaceNoise(scale float64) float64 {
+	if !(scale > 0) || math.IsInf(scale, 1) {
+		panic("fcrand: LaplaceNoise requires a positive finite scale")
+	}
+	u := float64Unit() - 0.5
+	for u == -0.5 { // exclude the endpoint, where the inverse CDF is infinite
+		u = float64Unit() - 0.5
+	}
+	if u < 0 {
+		return scale * math.Log1p(2*u)
+	}
+	return -scale * math.Log1p(-2*u)
+}
+
+// Float returns a uniform random big.Float in [0, 1) with precision prec.
+// The result is m/2^prec for a uniform random prec-bit integer m, so it carries exactly
+// prec random bits and is exactly representable at precision prec.
+// It panics if prec is 0 or exceeds big.MaxPrec.
+func Float(prec uint) *big.Float {
+	if prec == 0 || prec > big.MaxPrec {
+		panic("fcrand: Float precision must be in [1, big.MaxPrec]")
+	}
+	b := make([]byte, (prec+7)/8)
+	Read(b)
+	b[0] &= 0xFF >> (uint(len(b))*8 - prec) // keep exactly prec bits
+	f := new(big.Float).SetPrec(prec).SetInt(new(big.Int).SetBytes(b))
+	return f.SetMantExp(f, -int(prec))
+}
+
+// Triangular returns a sample from the triangular distribution with lower limit min,
+// upper limit max and peak at mode, using the inverse-CDF method.
+// It panics unless min <= mode <= max (all finite).
+func Triangular(min, mode, max float64) float64 {
+	if !(min <= mode && mode <= max) || math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("fcrand: Triangular requires finite min <= mode <= max")
+	}
+	if min == max {
+		return min
+	}
+	u := float64Unit()
+	width := max - min
+	if u < (mode-min)/width {
+		return min + math.Sqrt(u*width*(mode-min))
+	}
+	return max - math.Sqrt((1-u)*width*(max-mode))
+}
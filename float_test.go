@@ -0,0 +1,121 @@
+package fcrand
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// Test FloatRange stays within [min, max)
+func TestFloatRange(t *testing.T) {
+	ranges := [][2]float64{{0, 1}, {-1, 1}, {-10, -5}, {1e6, 1e6 + 1e-6}, {-math.MaxFloat64, math.MaxFloat64}}
+	for _, r := range ranges {
+		for range 1000 {
+			if v := FloatRange(r[0], r[1]); v < r[0] || v >= r[1] {
+				t.Fatalf("FloatRange(%v, %v) = %v", r[0], r[1], v)
+			}
+		}
+	}
+
+	for _, r := range [][2]float64{{1, 1}, {2, 1}, {0, math.Inf(1)}, {math.NaN(), 1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("FloatRange(%v, %v) did not panic", r[0], r[1])
+				}
+			}()
+			FloatRange(r[0], r[1])
+		}()
+	}
+}
+
+// Test LaplaceNoise sample mean is near 0 and variance near 2*scale^2
+func TestLaplaceNoise(t *testing.T) {
+	const n, scale = 50000, 3.0
+	var sum, sumSq float64
+	for range n {
+		x := LaplaceNoise(scale)
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			t.Fatalf("LaplaceNoise returned %v", x)
+		}
+		sum += x
+		sumSq += x * x
+	}
+	mean := sum / n
+	variance := sumSq/n - mean*mean
+	if math.Abs(mean) > 0.05*scale {
+		t.Fatalf("sample mean %v, want near 0", mean)
+	}
+	if want := 2 * scale * scale; math.Abs(variance-want) > 0.1*want {
+		t.Fatalf("sample variance %v, want near %v", variance, want)
+	}
+}
+
+// Test Float returns values in [0, 1) whose significant bits grow with prec
+func TestFloat(t *testing.T) {
+	one := big.NewFloat(1)
+	for _, prec := range []uint{1, 8, 24, 53, 200} {
+		beyondFloat64 := false
+		for range 100 {
+			f := Float(prec)
+			if f.Prec() != prec {
+				t.Fatalf("Float(%d) has precision %d", prec, f.Prec())
+			}
+			if f.Sign() < 0 || f.Cmp(one) >= 0 {
+				t.Fatalf("Float(%d) = %v, want in [0, 1)", prec, f)
+			}
+			if _, acc := f.Float64(); acc != big.Exact {
+				beyondFloat64 = true
+			}
+		}
+		if beyondFloat64 != (prec > 53) {
+			t.Fatalf("Float(%d) carries more than 53 significant bits: %v", prec, beyondFloat64)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Float(0) did not panic")
+		}
+	}()
+	Float(0)
+}
+
+// Test Triangular stays within [min, max] and clusters around the mode
+func TestTriangular(t *testing.T) {
+	tests := [][3]float64{{0, 2, 10}, {-5, -5, 5}, {1, 3, 3}, {2, 2, 2}}
+	for _, tc := range tests {
+		min, mode, max := tc[0], tc[1], tc[2]
+		const n = 20000
+		sum := 0.0
+		var bins [10]int
+		for range n {
+			v := Triangular(min, mode, max)
+			if v < min || v > max {
+				t.Fatalf("Triangular(%v, %v, %v) = %v", min, mode, max, v)
+			}
+			sum += v
+			if max > min {
+				bins[int(math.Min(9, (v-min)/(max-min)*10))]++
+			}
+		}
+		if want := (min + mode + max) / 3; math.Abs(sum/n-want) > 0.05*(max-min+1) {
+			t.Fatalf("Triangular(%v, %v, %v) mean %v, want %v", min, mode, max, sum/n, want)
+		}
+		if max > min {
+			modeBin := int(math.Min(9, (mode-min)/(max-min)*10))
+			for i, c := range bins {
+				if c > bins[modeBin] {
+					t.Fatalf("Triangular(%v, %v, %v) bin %d (%d) exceeds mode bin %d (%d)", min, mode, max, i, c, modeBin, bins[modeBin])
+				}
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Triangular(0, 5, 1) did not panic")
+		}
+	}()
+	Triangular(0, 5, 1)
+}
@@ -0,0 +1,21 @@
+package fcrand
+
+import "math"
+
+// LatLng returns a random coordinate uniform over the latitude/longitude rectangle,
+// with lat in the half-open range [-90, 90) and lng in [-180, 180) degrees, so the
+// north pole (lat 90) is never returned. Since meridians converge at the poles, these
+// points cluster toward the poles when plotted on a globe; use LatLngSphere for points
+// uniform over the Earth's surface.
+func LatLng() (lat, lng float64) {
+	return FloatRange(-90, 90), FloatRange(-180, 180)
+}
+
+// LatLngSphere returns a random coordinate uniform over the surface of a sphere,
+// with lat in the half-open range [-90, 90) (never the north pole) and lng in
+// [-180, 180) degrees. Latitude is drawn as asin(2u-1) for a uniform u, weighting
+// each latitude band by its area (∝ cos(lat)).
+func LatLngSphere() (lat, lng float64) {
+	lat = math.Asin(2*float64Unit()-1) * 180 / math.Pi
+	return lat, FloatRange(-180, 180)
+}
@@ -0,0 +1,34 @@
+package fcrand
+
+import (
+	"math"
+	"testing"
+)
+
+// Test LatLng and LatLngSphere ranges and latitude distributions
+func TestLatLng(t *testing.T) {
+	const n = 20000
+	tests := []struct {
+		name     string
+		f        func() (float64, float64)
+		tropical float64 // expected fraction with |lat| < 30
+	}{
+		{"LatLng", LatLng, 1.0 / 3},         // 60 of 180 degrees
+		{"LatLngSphere", LatLngSphere, 0.5}, // sin(30°) of the sphere area
+	}
+	for _, tc := range tests {
+		tropical := 0
+		for range n {
+			lat, lng := tc.f()
+			if lat < -90 || lat >= 90 || lng < -180 || lng >= 180 {
+				t.Fatalf("%s returned (%v, %v)", tc.name, lat, lng)
+			}
+			if math.Abs(lat) < 30 {
+				tropical++
+			}
+		}
+		if frac := float64(tropical) / n; math.Abs(frac-tc.tropical) > 0.02 {
+			t.Fatalf("%s: fraction with |lat| < 30 is %.3f, want %.3f", tc.name, frac, tc.tropical)
+		}
+	}
+}
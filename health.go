@@ -0,0 +1,71 @@
+package fcrand
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrRepeatedBlock is returned by a health-checking reader whose source produced
+// two identical consecutive blocks.
+var ErrRepeatedBlock = errors.New("fcrand: source produced identical consecutive blocks")
+
+// healthReader implements a continuous health test over the output of src.
+type healthReader struct {
+	src io.Reader
+
+	mu     sync.Mutex
+	prev   []byte // last complete block
+	cur    []byte // block being accumulated
+	primed bool   // prev holds a complete block
+	err    error  // sticky failure
+}
+
+// NewHealthCheckReader returns a reader which passes through the output of src while
+// running a continuous health test inspired by the FIPS 140 continuous RNG test:
+// the output is treated as a sequence of blockSize-byte blocks, and if any complete block
+// equals the block before it (a sign of a catastrophically broken RNG), Read returns
+// ErrRepeatedBlock, withholding the data read. The failure is permanent: all later
+// reads return ErrRepeatedBlock as well.
+//
+// The check costs one copy and one comparison per block. Small block sizes may fire on a
+// healthy source (two random 1-byte blocks collide with probability 1/256), so
+// blockSize should be at least 8. The returned reader is safe for concurrent use.
+// NewHealthCheckReader panics if blockSize <= 0.
+func NewHealthCheckReader(src io.Reader, blockSize int) io.Reader {
+	if blockSize <= 0 {
+		panic("fcrand: health check block size must be positive")
+	}
+	return &healthReader{
+		src:  src,
+		prev: make([]byte, blockSize),
+		cur:  make([]byte, 0, blockSize),
+	}
+}
+
+func (h *healthReader) Read(b []byte) (int, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.err != nil {
+		return 0, h.err
+	}
+	n, err := h.src.Read(b)
+	for data := b[:n]; len(data) > 0; {
+		c := min(len(data), cap(h.cur)-len(h.cur))
+		h.cur = append(h.cur, data[:c]...)
+		data = data[c:]
+		if len(h.cur) < cap(h.cur) {
+			break
+		}
+		if h.primed && bytes.Equal(h.cur, h.prev) {
+			clear(b[:n])
+			h.err = ErrRepeatedBlock
+			return 0, h.err
+		}
+		h.prev, h.cur = h.cur, h.prev[:0]
+		h.primed = true
+	}
+	return n, err
+}
@@ -0,0 +1,48 @@
+package fcrand
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+// stuckReader always returns the same byte
+type stuckReader byte
+
+func (s stuckReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = byte(s)
+	}
+	return len(b), nil
+}
+
+// Test NewHealthCheckReader fires on a stuck source and stays failed
+func TestNewHealthCheckReader_Stuck(t *testing.T) {
+	r := NewHealthCheckReader(stuckReader(0xAB), 16)
+	buf := make([]byte, 8)
+	for range 3 { // 24 bytes: one complete block and half of the next
+		if _, err := r.Read(buf); err != nil {
+			t.Fatalf("Read failed before a second block was complete: %v", err)
+		}
+	}
+	if n, err := r.Read(buf); n != 0 || !errors.Is(err, ErrRepeatedBlock) {
+		t.Fatalf("Read returned n=%d, err=%v, want 0, ErrRepeatedBlock", n, err)
+	}
+	if buf[0] != 0 {
+		t.Fatal("Read did not withhold the data of a failed block")
+	}
+	if _, err := r.Read(buf); !errors.Is(err, ErrRepeatedBlock) {
+		t.Fatalf("Read after failure returned err=%v, want ErrRepeatedBlock", err)
+	}
+}
+
+// Test NewHealthCheckReader passes real randomness through
+func TestNewHealthCheckReader_Random(t *testing.T) {
+	r := NewHealthCheckReader(Reader, 16)
+	buf := make([]byte, 1000)
+	for range 100 {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			t.Fatalf("Read returned error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package fcrand
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// randomHex returns the lowercase hex encoding of nbytes random bytes.
+func randomHex(nbytes int) string {
+	b := make([]byte, nbytes)
+	Read(b)
+	return hex.EncodeToString(b)
+}
+
+// HexGrouped returns the lowercase hex encoding of nbytes random bytes with sep inserted
+// between every groupLen hex characters, eg. "ab:cd:ef" for HexGrouped(3, 2, ":"),
+// which is handy for fingerprints and colon-delimited identifiers.
+// If the hex length is not a multiple of groupLen, the last group is shorter;
+// there is never a trailing separator. It panics if nbytes < 0 or groupLen <= 0.
+func HexGrouped(nbytes, groupLen int, sep string) string {
+	if groupLen <= 0 {
+		panic("fcrand: HexGrouped requires a positive group length")
+	}
+	h := randomHex(nbytes)
+	if len(h) <= groupLen {
+		return h
+	}
+	var sb strings.Builder
+	sb.Grow(len(h) + (len(h)-1)/groupLen*len(sep))
+	for len(h) > groupLen {
+		sb.WriteString(h[:groupLen])
+		sb.WriteString(sep)
+		h = h[groupLen:]
+	}
+	sb.WriteString(h)
+	return sb.String()
+}
+
+// HexColor returns a random CSS hex color of the form "#rrggbb", eg. "#3fa91c".
+func HexColor() string {
+	return "#" + randomHex(3)
+}
+
+// RGB returns the red, green and blue components of a random color.
+func RGB() (r, g, b uint8) {
+	var c [3]byte
+	Read(c[:])
+	return c[0], c[1], c[2]
+}
@@ -0,0 +1,61 @@
+package fcrand
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// Test HexGrouped group lengths and separators
+func TestHexGrouped(t *testing.T) {
+	tests := []struct {
+		nbytes, groupLen int
+		sep              string
+		groups           int
+		lastLen          int
+	}{
+		{3, 2, ":", 3, 2},
+		{16, 4, "-", 8, 4},
+		{5, 3, " ", 4, 1},
+		{4, 100, ":", 1, 8},
+		{0, 2, ":", 1, 0},
+	}
+	for _, tc := range tests {
+		s := HexGrouped(tc.nbytes, tc.groupLen, tc.sep)
+		groups := strings.Split(s, tc.sep)
+		if len(groups) != tc.groups {
+			t.Fatalf("HexGrouped(%d, %d, %q) = %q, want %d groups", tc.nbytes, tc.groupLen, tc.sep, s, tc.groups)
+		}
+		for i, g := range groups {
+			want := tc.groupLen
+			if i == len(groups)-1 {
+				want = tc.lastLen
+			}
+			if len(g) != want {
+				t.Fatalf("HexGrouped(%d, %d, %q) = %q, group %d has length %d, want %d", tc.nbytes, tc.groupLen, tc.sep, s, i, len(g), want)
+			}
+		}
+		if b, err := hex.DecodeString(strings.Join(groups, "")); err != nil || len(b) != tc.nbytes {
+			t.Fatalf("HexGrouped(%d, %d, %q) = %q is not hex of %d bytes", tc.nbytes, tc.groupLen, tc.sep, s, tc.nbytes)
+		}
+	}
+}
+
+// Test HexColor matches #RRGGBB and RGB returns varied components
+func TestHexColor(t *testing.T) {
+	for range 100 {
+		c := HexColor()
+		if len(c) != 7 || c[0] != '#' || strings.Trim(c[1:], "0123456789abcdef") != "" {
+			t.Fatalf("HexColor returned %q", c)
+		}
+	}
+
+	seen := map[[3]uint8]bool{}
+	for range 10 {
+		r, g, b := RGB()
+		seen[[3]uint8{r, g, b}] = true
+	}
+	if len(seen) < 9 {
+		t.Fatalf("RGB returned only %d distinct colors out of 10", len(seen))
+	}
+}
@@ -0,0 +1,21 @@
+package fcrand
+
+import "net/http"
+
+const (
+	headerNameLength     = 10 // random characters after the "X-" prefix
+	headerValueMaxLength = 32
+)
+
+// RandomHeaders returns count random but syntactically valid HTTP headers with distinct
+// names, eg. for fuzzing HTTP handlers and middleware. Names are "X-" followed by random
+// letters and digits (in canonical form); values are 1 to 32 random visible ASCII
+// characters (0x21-0x7E). RandomHeaders returns an empty Header if count <= 0.
+func RandomHeaders(count int) http.Header {
+	h := make(http.Header, max(count, 0))
+	for len(h) < count {
+		name := "X-" + randomString(lowerAlphanumeric, headerNameLength)
+		h.Set(name, randomString(printableASCII[1:], 1+int(uint64n(headerValueMaxLength))))
+	}
+	return h
+}
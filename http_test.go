@@ -0,0 +1,30 @@
+package fcrand
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+var (
+	headerToken = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$") // RFC 9110 token
+	headerValue = regexp.MustCompile(`^[\x21-\x7E]+$`)
+)
+
+// Test RandomHeaders count and name/value validity
+func TestRandomHeaders(t *testing.T) {
+	for _, count := range []int{0, 1, 50} {
+		h := RandomHeaders(count)
+		if len(h) != count {
+			t.Fatalf("RandomHeaders(%d) returned %d headers", count, len(h))
+		}
+		for name, values := range h {
+			if !headerToken.MatchString(name) || http.CanonicalHeaderKey(name) != name {
+				t.Fatalf("RandomHeaders returned invalid name %q", name)
+			}
+			if len(values) != 1 || !headerValue.MatchString(values[0]) || len(values[0]) > headerValueMaxLength {
+				t.Fatalf("RandomHeaders returned invalid values %q for %q", values, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package fcrand
+
+import (
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	jsonMaxContainerLen = 4  // max elements in a generated array or object
+	jsonMaxStringLen    = 16 // max length of a generated string or object key
+)
+
+// RandomJSON returns a randomly structured value suitable for json.Marshal, for fuzzing
+// JSON consumers. The value is built from nil, bool, float64, string, []any and
+// map[string]any, with containers nested at most depth levels deep (a scalar has
+// depth 0) and holding at most 4 elements each, which bounds the total size.
+func RandomJSON(depth int) any {
+	kinds := uint64(4) // scalars only
+	if depth > 0 {
+		kinds = 6
+	}
+	switch uint64n(kinds) {
+	case 0:
+		return nil
+	case 1:
+		return uint64n(2) == 1
+	case 2:
+		return FloatRange(-1e6, 1e6)
+	case 3:
+		return randomJSONString()
+	case 4:
+		arr := make([]any, uint64n(jsonMaxContainerLen+1))
+		for i := range arr {
+			arr[i] = RandomJSON(depth - 1)
+		}
+		return arr
+	default:
+		obj := make(map[string]any)
+		for range uint64n(jsonMaxContainerLen + 1) {
+			obj[randomJSONString()] = RandomJSON(depth - 1)
+		}
+		return obj
+	}
+}
+
+// randomJSONString returns a random base32 string of 1 to jsonMaxStringLen characters.
+func randomJSONString() string {
+	return base32Text(1 + int(uint64n(jsonMaxStringLen)))
+}
+
+// jsonShortEscapes maps the characters with two-character JSON escapes to their escape letter.
+var jsonShortEscapes = map[rune]byte{'"': '"', '\\': '\\', '/': '/', '\b': 'b', '\f': 'f', '\n': 'n', '\r': 'r', '\t': 't'}
+
+// JSONString returns a valid JSON string literal, including the surrounding quotes, whose
+// decoded content is 0 to maxLen random characters, eg. for fuzzing JSON string parsers.
+// The content mixes printable ASCII with quotes, backslashes, control characters and
+// non-ASCII runes, and escapable characters are randomly written in their short
+// (eg. \n), \uXXXX (with surrogate pairs above U+FFFF) or, where allowed, raw form.
+// It panics if maxLen < 0.
+func JSONString(maxLen int) string {
+	s, _ := jsonString(maxLen)
+	return s
+}
+
+// jsonString returns a random JSON string literal together with its decoded content.
+func jsonString(maxLen int) (literal, content string) {
+	if maxLen < 0 {
+		panic("fcrand: JSONString requires maxLen >= 0")
+	}
+	n := int(uint64n(uint64(maxLen) + 1))
+	var lit, raw strings.Builder
+	lit.WriteByte('"')
+	for range n {
+		var r rune
+		switch uint64n(8) {
+		case 0:
+			r = rune(`"\/`[uint64n(3)])
+		case 1:
+			r = rune(uint64n(0x20)) // control character
+		case 2:
+			r = RuneIn(utf8.RuneSelf, utf8.MaxRune)
+		default:
+			r = rune(printableASCII[uint64n(uint64(len(printableASCII)))])
+		}
+		raw.WriteRune(r)
+
+		short, hasShort := jsonShortEscapes[r]
+		mustEscape := r < 0x20 || r == '"' || r == '\\'
+		switch {
+		case hasShort && uint64n(2) == 0:
+			lit.WriteByte('\\')
+			lit.WriteByte(short)
+		case mustEscape || uint64n(4) == 0:
+			if r1, r2 := utf16.EncodeRune(r); r1 != utf8.RuneError {
+				writeJSONUnicodeEscape(&lit, r1)
+				r = r2
+			}
+			writeJSONUnicodeEscape(&lit, r)
+		default:
+			lit.WriteRune(r)
+		}
+	}
+	lit.WriteByte('"')
+	return lit.String(), raw.String()
+}
+
+// writeJSONUnicodeEscape writes the \uXXXX escape of the UTF-16 code unit r, with hex
+// digits in random case.
+func writeJSONUnicodeEscape(sb *strings.Builder, r rune) {
+	const lower, upper = "0123456789abcdef", "0123456789ABCDEF"
+	digits := lower
+	if uint64n(2) == 0 {
+		digits = upper
+	}
+	sb.WriteString(`\u`)
+	for shift := 12; shift >= 0; shift -= 4 {
+		sb.WriteByte(digits[r>>shift&0xF])
+	}
+}
@@ -0,0 +1,77 @@
+package fcrand
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// jsonDepth returns the container nesting depth of v
+func jsonDepth(v any) int {
+	d := 0
+	switch v := v.(type) {
+	case []any:
+		for _, e := range v {
+			d = max(d, jsonDepth(e))
+		}
+		return d + 1
+	case map[string]any:
+		for _, e := range v {
+			d = max(d, jsonDepth(e))
+		}
+		return d + 1
+	}
+	return 0
+}
+
+// Test RandomJSON always marshals and respects the depth bound
+func TestRandomJSON(t *testing.T) {
+	for depth := range 5 {
+		for range 200 {
+			v := RandomJSON(depth)
+			if _, err := json.Marshal(v); err != nil {
+				t.Fatalf("json.Marshal(RandomJSON(%d)) returned error: %v", depth, err)
+			}
+			if d := jsonDepth(v); d > depth {
+				t.Fatalf("RandomJSON(%d) returned value of depth %d", depth, d)
+			}
+		}
+	}
+}
+
+// Test JSONString literals decode to their content and exercise escapes
+func TestJSONString(t *testing.T) {
+	const maxLen = 40
+	var sawQuote, sawBackslash, sawControl, sawUnicodeEscape, sawSurrogatePair bool
+	for range 2000 {
+		lit, content := jsonString(maxLen)
+		var got string
+		if err := json.Unmarshal([]byte(lit), &got); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", lit, err)
+		}
+		if got != content {
+			t.Fatalf("literal %s decoded to %q, want %q", lit, got, content)
+		}
+		if c := utf8.RuneCountInString(content); c > maxLen {
+			t.Fatalf("content has %d runes, want <= %d", c, maxLen)
+		}
+		sawQuote = sawQuote || strings.Contains(content, `"`)
+		sawBackslash = sawBackslash || strings.Contains(content, `\`)
+		sawControl = sawControl || strings.ContainsFunc(content, func(r rune) bool { return r < 0x20 })
+		sawUnicodeEscape = sawUnicodeEscape || strings.Contains(lit, `\u`)
+		sawSurrogatePair = sawSurrogatePair || strings.Contains(lit, `\ud`) || strings.Contains(lit, `\uD`)
+	}
+	if !sawQuote || !sawBackslash || !sawControl || !sawUnicodeEscape || !sawSurrogatePair {
+		t.Fatalf("missing escapes: quote %v, backslash %v, control %v, \\u %v, surrogate pair %v",
+			sawQuote, sawBackslash, sawControl, sawUnicodeEscape, sawSurrogatePair)
+	}
+
+	if s := JSONString(0); s != `""` {
+		t.Fatalf(`JSONString(0) = %s, want ""`, s)
+	}
+	var got string
+	if err := json.Unmarshal([]byte(JSONString(100)), &got); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,138 @@
+package fcrand
+
+import (
+	"math"
+	"unsafe"
+)
+
+// ReadMatrix returns a rows×cols matrix of cryptographically secure random bytes.
+// All rows share one contiguous backing array filled by a single Read,
+// so ReadMatrix makes only two allocations regardless of the row count.
+// A zero dimension yields an empty matrix; ReadMatrix panics if either dimension is negative.
+func ReadMatrix(rows, cols int) [][]byte {
+	if rows < 0 || cols < 0 {
+		panic("fcrand: negative matrix dimension")
+	}
+	backing := make([]byte, rows*cols)
+	Read(backing)
+	m := make([][]byte, rows)
+	for i := range m {
+		m[i] = backing[i*cols : (i+1)*cols : (i+1)*cols]
+	}
+	return m
+}
+
+// RandomGraph returns the n×n symmetric adjacency matrix of an Erdős–Rényi random graph,
+// in which each of the n(n-1)/2 undirected edges exists independently with probability p.
+// The diagonal is false (no self-loops). It panics if n < 0 or p is not in [0, 1].
+func RandomGraph(n int, p float64) [][]bool {
+	if n < 0 {
+		panic("fcrand: negative matrix dimension")
+	}
+	if !(p >= 0 && p <= 1) {
+		panic("fcrand: probability must be in [0, 1]")
+	}
+	edges := make([]bool, n*(n-1)/2)
+	fillBernoulli(edges, p)
+
+	backing := make([]bool, n*n)
+	m := make([][]bool, n)
+	for i := range m {
+		m[i] = backing[i*n : (i+1)*n : (i+1)*n]
+	}
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			m[i][j], m[j][i] = edges[0], edges[0]
+			edges = edges[1:]
+		}
+	}
+	return m
+}
+
+// WeightedGraph returns an undirected Erdős–Rényi random graph on vertices [0, n) with
+// random edge weights, eg. for testing shortest-path and minimum spanning tree algorithms.
+// Each of the n(n-1)/2 edges exists independently with probability p (as in RandomGraph),
+// and is keyed by its endpoints {i, j} with i < j, mapped to a uniform random weight in
+// [1, maxWeight]. Memory is proportional to the number of edges, but every vertex pair is
+// still considered, so the running time is quadratic in n.
+// It panics if n < 0, p is not in [0, 1] or maxWeight < 1.
+func WeightedGraph(n int, p float64, maxWeight int) map[[2]int]int {
+	if n < 0 {
+		panic("fcrand: negative matrix dimension")
+	}
+	if !(p >= 0 && p <= 1) {
+		panic("fcrand: probability must be in [0, 1]")
+	}
+	if maxWeight < 1 {
+		panic("fcrand: WeightedGraph requires maxWeight >= 1")
+	}
+	g := make(map[[2]int]int)
+	var buf [512]bool
+	var flips []bool // unused edge flips left in buf
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			if len(flips) == 0 {
+				flips = buf[:]
+				fillBernoulli(flips, p)
+			}
+			if flips[0] {
+				g[[2]int{i, j}] = 1 + int(uint64n(uint64(maxWeight)))
+			}
+			flips = flips[1:]
+		}
+	}
+	return g
+}
+
+// FloatMatrix returns a rows×cols matrix of uniform random float64 values in [0, 1).
+// All rows share one contiguous backing array whose bits are filled by a single bulk read
+// and then converted in place. A zero dimension yields an empty matrix;
+// FloatMatrix panics if either dimension is negative.
+func FloatMatrix(rows, cols int) [][]float64 {
+	if rows < 0 || cols < 0 {
+		panic("fcrand: negative matrix dimension")
+	}
+	backing := make([]float64, rows*cols)
+	if len(backing) > 0 {
+		ReadUint64s(unsafe.Slice((*uint64)(unsafe.Pointer(&backing[0])), len(backing)))
+	}
+	for i, f := range backing {
+		backing[i] = float64(math.Float64bits(f)>>11) / (1 << 53)
+	}
+	m := make([][]float64, rows)
+	for i := range m {
+		m[i] = backing[i*cols : (i+1)*cols : (i+1)*cols]
+	}
+	return m
+}
+
+// PermutationMatrix returns an n×n boolean permutation matrix, with exactly one true entry
+// in each row and column, derived from a uniform random permutation (see CryptoRand.Perm).
+// It returns an empty matrix if n == 0, and panics if n < 0.
+func PermutationMatrix(n int) [][]bool {
+	if n < 0 {
+		panic("fcrand: negative matrix dimension")
+	}
+	backing := make([]bool, n*n)
+	m := make([][]bool, n)
+	for i, j := range NewRand().Perm(n) {
+		m[i] = backing[i*n : (i+1)*n : (i+1)*n]
+		m[i][j] = true
+	}
+	return m
+}
+
+// SparseVector returns a sparse vector of dimension dim as a map from nonzero distinct
+// uniform random indices in [0, dim) (see DistinctInts) to uniform random values in [0, 1),
+// eg. for testing sparse linear algebra. It panics unless 0 <= nonzero <= dim.
+func SparseVector(dim, nonzero int) map[int]float64 {
+	indices, err := DistinctInts(nonzero, dim)
+	if err != nil {
+		panic("fcrand: SparseVector requires 0 <= nonzero <= dim")
+	}
+	v := make(map[int]float64, nonzero)
+	for _, i := range indices {
+		v[i] = float64Unit()
+	}
+	return v
+}
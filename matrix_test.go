@@ -0,0 +1,205 @@
+package fcrand
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+// Test ReadMatrix dimensions, content and allocation count
+func TestReadMatrix(t *testing.T) {
+	m := ReadMatrix(8, 16)
+	if len(m) != 8 {
+		t.Fatalf("ReadMatrix returned %d rows, want 8", len(m))
+	}
+	for i, row := range m {
+		if len(row) != 16 || cap(row) != 16 {
+			t.Fatalf("row %d has len=%d cap=%d, want 16", i, len(row), cap(row))
+		}
+		if bytes.Equal(row, make([]byte, 16)) {
+			t.Fatalf("row %d is all zero bytes", i)
+		}
+	}
+
+	if m := ReadMatrix(0, 16); len(m) != 0 {
+		t.Fatalf("ReadMatrix(0, 16) returned %d rows, want 0", len(m))
+	}
+	for _, row := range ReadMatrix(3, 0) {
+		if len(row) != 0 {
+			t.Fatalf("ReadMatrix(3, 0) returned row of length %d", len(row))
+		}
+	}
+
+	matrixAllocs := testing.AllocsPerRun(100, func() { ReadMatrix(32, 8) })
+	perRowAllocs := testing.AllocsPerRun(100, func() {
+		m := make([][]byte, 32)
+		for i := range m {
+			m[i] = make([]byte, 8)
+			Read(m[i])
+		}
+	})
+	if (matrixAllocs != 2 && !raceEnabled) || matrixAllocs >= perRowAllocs { // Read may allocate under -race
+		t.Fatalf("ReadMatrix allocs = %v, per-row allocs = %v", matrixAllocs, perRowAllocs)
+	}
+}
+
+// Test ReadMatrix panics on negative dimensions
+func TestReadMatrix_Negative(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ReadMatrix(-1, 1) did not panic")
+		}
+	}()
+	ReadMatrix(-1, 1)
+}
+
+// Test RandomGraph is symmetric with a false diagonal and density near p
+func TestRandomGraph(t *testing.T) {
+	const n = 200
+	for _, p := range []float64{0, 0.1, 0.5, 1} {
+		g := RandomGraph(n, p)
+		if len(g) != n {
+			t.Fatalf("RandomGraph(%d, %v) returned %d rows", n, p, len(g))
+		}
+		edges := 0
+		for i := range n {
+			if g[i][i] {
+				t.Fatalf("RandomGraph(%d, %v) has self-loop at %d", n, p, i)
+			}
+			for j := i + 1; j < n; j++ {
+				if g[i][j] != g[j][i] {
+					t.Fatalf("RandomGraph(%d, %v) is not symmetric at (%d, %d)", n, p, i, j)
+				}
+				if g[i][j] {
+					edges++
+				}
+			}
+		}
+		if density := float64(edges) / (n * (n - 1) / 2); math.Abs(density-p) > 0.02 {
+			t.Fatalf("RandomGraph(%d, %v) density %v", n, p, density)
+		}
+	}
+	if g := RandomGraph(0, 0.5); len(g) != 0 {
+		t.Fatalf("RandomGraph(0, 0.5) returned %d rows", len(g))
+	}
+}
+
+// Test FloatMatrix dimensions and value range
+func TestFloatMatrix(t *testing.T) {
+	m := FloatMatrix(20, 30)
+	if len(m) != 20 {
+		t.Fatalf("FloatMatrix returned %d rows, want 20", len(m))
+	}
+	seen := map[float64]bool{}
+	for i, row := range m {
+		if len(row) != 30 {
+			t.Fatalf("row %d has %d columns, want 30", i, len(row))
+		}
+		for _, v := range row {
+			if v < 0 || v >= 1 {
+				t.Fatalf("FloatMatrix value %v out of [0, 1)", v)
+			}
+			seen[v] = true
+		}
+	}
+	if len(seen) != 600 {
+		t.Fatalf("FloatMatrix returned %d distinct values out of 600", len(seen))
+	}
+
+	if m := FloatMatrix(0, 5); len(m) != 0 {
+		t.Fatalf("FloatMatrix(0, 5) returned %d rows", len(m))
+	}
+	for _, row := range FloatMatrix(2, 0) {
+		if len(row) != 0 {
+			t.Fatalf("FloatMatrix(2, 0) returned row of length %d", len(row))
+		}
+	}
+}
+
+// Test PermutationMatrix has exactly one true entry per row and column
+func TestPermutationMatrix(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 50} {
+		m := PermutationMatrix(n)
+		if len(m) != n {
+			t.Fatalf("PermutationMatrix(%d) returned %d rows", n, len(m))
+		}
+		colCounts := make([]int, n)
+		for i, row := range m {
+			rowCount := 0
+			for j, v := range row {
+				if v {
+					rowCount++
+					colCounts[j]++
+				}
+			}
+			if len(row) != n || rowCount != 1 {
+				t.Fatalf("PermutationMatrix(%d) row %d has length %d and %d true entries", n, i, len(row), rowCount)
+			}
+		}
+		for j, c := range colCounts {
+			if c != 1 {
+				t.Fatalf("PermutationMatrix(%d) column %d has %d true entries", n, j, c)
+			}
+		}
+	}
+}
+
+// Test SparseVector entry count, index range and value range
+func TestSparseVector(t *testing.T) {
+	for _, tc := range [][2]int{{0, 0}, {10, 0}, {10, 10}, {1000000, 50}, {100, 40}} {
+		dim, nonzero := tc[0], tc[1]
+		v := SparseVector(dim, nonzero)
+		if len(v) != nonzero {
+			t.Fatalf("SparseVector(%d, %d) has %d entries", dim, nonzero, len(v))
+		}
+		for i, x := range v {
+			if i < 0 || i >= dim {
+				t.Fatalf("SparseVector(%d, %d) has out-of-range index %d", dim, nonzero, i)
+			}
+			if x < 0 || x >= 1 {
+				t.Fatalf("SparseVector(%d, %d) has out-of-range value %v", dim, nonzero, x)
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SparseVector(5, 6) did not panic")
+		}
+	}()
+	SparseVector(5, 6)
+}
+
+// Test WeightedGraph edge density, endpoint order and weight range
+func TestWeightedGraph(t *testing.T) {
+	const n, maxWeight = 200, 10
+	for _, p := range []float64{0, 0.1, 0.5, 1} {
+		g := WeightedGraph(n, p, maxWeight)
+		weights := map[int]bool{}
+		for e, w := range g {
+			if e[0] < 0 || e[0] >= e[1] || e[1] >= n {
+				t.Fatalf("WeightedGraph(%d, %v) has invalid edge %v", n, p, e)
+			}
+			if w < 1 || w > maxWeight {
+				t.Fatalf("WeightedGraph(%d, %v) has out-of-range weight %d", n, p, w)
+			}
+			weights[w] = true
+		}
+		if got := float64(len(g)) / (n * (n - 1) / 2); math.Abs(got-p) > 0.02 {
+			t.Fatalf("WeightedGraph(%d, %v) edge density %v", n, p, got)
+		}
+		if p > 0 && len(weights) != maxWeight {
+			t.Fatalf("WeightedGraph(%d, %v) used %d distinct weights", n, p, len(weights))
+		}
+	}
+	if g := WeightedGraph(0, 1, 1); len(g) != 0 {
+		t.Fatalf("WeightedGraph(0) has %d edges", len(g))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("WeightedGraph with maxWeight 0 did not panic")
+		}
+	}()
+	WeightedGraph(5, 0.5, 0)
+}
@@ -0,0 +1,7 @@
+//go:build race
+
+package fcrand
+
+// raceEnabled reports whether the race detector is on. Under -race, sync.Pool drops Puts
+// at random, so paths through the cache may allocate and exact allocation counts are skipped.
+const raceEnabled = true
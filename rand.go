@@ -0,0 +1,111 @@
+package fcrand
+
+import "math"
+
+// CryptoRand offers the familiar method set of math/rand's Rand, backed entirely by the
+// fcrand cache, so every value is cryptographically secure. There is no Seed method.
+// A CryptoRand holds no state and is safe for concurrent use.
+type CryptoRand struct{}
+
+// NewRand returns a new CryptoRand.
+func NewRand() *CryptoRand {
+	return &CryptoRand{}
+}
+
+// Int63 returns a non-negative random 63-bit integer as an int64.
+func (*CryptoRand) Int63() int64 { return int64(randUint64() >> 1) }
+
+// Uint32 returns a random 32-bit value as a uint32.
+func (*CryptoRand) Uint32() uint32 { return uint32(randUint64() >> 32) }
+
+// Uint64 returns a random 64-bit value as a uint64.
+func (*CryptoRand) Uint64() uint64 { return randUint64() }
+
+// Int31 returns a non-negative random 31-bit integer as an int32.
+func (*CryptoRand) Int31() int32 { return int32(randUint64() >> 33) }
+
+// Int returns a non-negative random int.
+func (*CryptoRand) Int() int { return int(uint(randUint64()) << 1 >> 1) }
+
+// Int63n returns, as an int64, a non-negative uniform random number in [0, n).
+// It panics if n <= 0.
+func (*CryptoRand) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("fcrand: invalid argument to Int63n")
+	}
+	return int64(uint64n(uint64(n)))
+}
+
+// Int31n returns, as an int32, a non-negative uniform random number in [0, n).
+// It panics if n <= 0.
+func (*CryptoRand) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("fcrand: invalid argument to Int31n")
+	}
+	return int32(uint64n(uint64(n)))
+}
+
+// Intn returns, as an int, a non-negative uniform random number in [0, n).
+// It panics if n <= 0.
+func (*CryptoRand) Intn(n int) int {
+	if n <= 0 {
+		panic("fcrand: invalid argument to Intn")
+	}
+	return int(uint64n(uint64(n)))
+}
+
+// Float64 returns, as a float64, a uniform random number in [0.0, 1.0).
+func (*CryptoRand) Float64() float64 { return float64Unit() }
+
+// Float32 returns, as a float32, a uniform random number in [0.0, 1.0).
+func (*CryptoRand) Float32() float32 {
+	return float32(randUint64()>>40) / (1 << 24)
+}
+
+// NormFloat64 returns a normally distributed float64 with mean 0 and standard deviation 1,
+// using the Marsaglia polar method.
+func (*CryptoRand) NormFloat64() float64 {
+	for {
+		u, v := 2*float64Unit()-1, 2*float64Unit()-1
+		if s := u*u + v*v; s > 0 && s < 1 {
+			return u * math.Sqrt(-2*math.Log(s)/s)
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 in (0, +math.MaxFloat64]
+// with rate parameter 1 (mean 1), using the inverse-CDF method.
+func (*CryptoRand) ExpFloat64() float64 {
+	for {
+		if u := float64Unit(); u > 0 {
+			return -math.Log(u)
+		}
+	}
+}
+
+// Perm returns, as a slice of n ints, a uniform random permutation of [0, n).
+// It panics if n < 0.
+func (*CryptoRand) Perm(n int) []int {
+	p := make([]int, n)
+	for i := range p {
+		j := uint64n(uint64(i + 1))
+		p[i] = p[j]
+		p[j] = i
+	}
+	return p
+}
+
+// Shuffle performs an unbiased Fisher–Yates shuffle of n elements, calling swap to
+// exchange the elements with indexes i and j. It panics if n < 0.
+func (*CryptoRand) Shuffle(n int, swap func(i, j int)) {
+	if n < 0 {
+		panic("fcrand: invalid argument to Shuffle")
+	}
+	for i := n - 1; i > 0; i-- {
+		swap(i, int(uint64n(uint64(i+1))))
+	}
+}
+
+// Read fills p with cryptographically secure random bytes.
+// It always returns len(p) and a nil error.
+func (*CryptoRand) Read(p []byte) (n int, err error) { return Read(p) }
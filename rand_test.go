@@ -0,0 +1,99 @@
+package fcrand
+
+import (
+	"bytes"
+	"math"
+	"slices"
+	"testing"
+)
+
+// Test the CryptoRand method set stays within documented ranges
+func TestCryptoRand(t *testing.T) {
+	r := NewRand()
+	var normSum, normSumSq, expSum float64
+	const n = 10000
+	for range n {
+		if v := r.Int63(); v < 0 {
+			t.Fatalf("Int63 = %d", v)
+		}
+		if v := r.Int31(); v < 0 {
+			t.Fatalf("Int31 = %d", v)
+		}
+		if v := r.Int(); v < 0 {
+			t.Fatalf("Int = %d", v)
+		}
+		if v := r.Intn(10); v < 0 || v >= 10 {
+			t.Fatalf("Intn(10) = %d", v)
+		}
+		if v := r.Int63n(1 << 40); v < 0 || v >= 1<<40 {
+			t.Fatalf("Int63n = %d", v)
+		}
+		if v := r.Int31n(7); v < 0 || v >= 7 {
+			t.Fatalf("Int31n(7) = %d", v)
+		}
+		if v := r.Float64(); v < 0 || v >= 1 {
+			t.Fatalf("Float64 = %v", v)
+		}
+		if v := r.Float32(); v < 0 || v >= 1 {
+			t.Fatalf("Float32 = %v", v)
+		}
+		x := r.NormFloat64()
+		normSum += x
+		normSumSq += x * x
+		e := r.ExpFloat64()
+		if e <= 0 {
+			t.Fatalf("ExpFloat64 = %v", e)
+		}
+		expSum += e
+	}
+	if mean, variance := normSum/n, normSumSq/n; math.Abs(mean) > 0.05 || math.Abs(variance-1) > 0.1 {
+		t.Fatalf("NormFloat64 mean %v, variance %v, want 0, 1", mean, variance)
+	}
+	if mean := expSum / n; math.Abs(mean-1) > 0.05 {
+		t.Fatalf("ExpFloat64 mean %v, want 1", mean)
+	}
+	if r.Uint32() == r.Uint32() && r.Uint64() == r.Uint64() {
+		t.Fatal("Uint32 and Uint64 repeated values")
+	}
+
+	p := r.Perm(100)
+	sorted := slices.Clone(p)
+	slices.Sort(sorted)
+	for i, v := range sorted {
+		if v != i {
+			t.Fatalf("Perm(100) is not a permutation: %v", p)
+		}
+	}
+	if slices.Equal(p, sorted) {
+		t.Fatal("Perm(100) returned the identity permutation")
+	}
+
+	s := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	r.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+	sorted = slices.Clone(s)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Fatalf("Shuffle did not preserve elements: %v", s)
+	}
+
+	buf := make([]byte, 32)
+	if n, err := r.Read(buf); n != 32 || err != nil || bytes.Equal(buf, make([]byte, 32)) {
+		t.Fatalf("Read returned n=%d, err=%v, buf=%x", n, err, buf)
+	}
+
+	for name, f := range map[string]func(){
+		"Intn(0)":     func() { r.Intn(0) },
+		"Int63n(0)":   func() { r.Int63n(0) },
+		"Int31n(-1)":  func() { r.Int31n(-1) },
+		"Shuffle(-1)": func() { r.Shuffle(-1, nil) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("%s did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,92 @@
+package fcrand
+
+import "hash"
+
+// ReadNonZero fills b with cryptographically secure random bytes, none of which is zero.
+// Each byte is uniform over [1, 255]: b is filled once, and then only the zero bytes
+// are redrawn (in batches) until none remain.
+func ReadNonZero(b []byte) {
+	Read(b)
+	var buf [64]byte
+	for {
+		zeros := 0
+		for _, c := range b {
+			if c == 0 {
+				zeros++
+			}
+		}
+		if zeros == 0 {
+			return
+		}
+		r := buf[:min(zeros, len(buf))]
+		Read(r)
+		for i := range b {
+			if len(r) == 0 {
+				break
+			}
+			if b[i] == 0 {
+				b[i] = r[0]
+				r = r[1:]
+			}
+		}
+	}
+}
+
+// ReadHashed returns n cryptographically secure random bytes together with their digest
+// under h, eg. a secret and its commitment. h is Reset before use. It panics if n < 0.
+func ReadHashed(n int, h hash.Hash) (b, digest []byte) {
+	b = make([]byte, n)
+	Read(b)
+	h.Reset()
+	h.Write(b)
+	return b, h.Sum(nil)
+}
+
+// ReadLengthPrefixed returns a slice of random length in [0, maxLen] filled with random
+// bytes, eg. for fuzzing length-delimited wire formats (the slice length plays the role
+// of the length prefix). It panics if maxLen < 0.
+func ReadLengthPrefixed(maxLen int) []byte {
+	if maxLen < 0 {
+		panic("fcrand: ReadLengthPrefixed requires maxLen >= 0")
+	}
+	b := make([]byte, uint64n(uint64(maxLen)+1))
+	Read(b)
+	return b
+}
+
+// ReadVariadic returns count slices of random bytes, each of uniform random length in
+// [minLen, maxLen], eg. for bulk fuzz corpus generation. All slices share one backing
+// array filled by a single Read, so the content costs one bulk draw.
+// It panics unless count >= 0 and 0 <= minLen <= maxLen.
+func ReadVariadic(count, minLen, maxLen int) [][]byte {
+	if count < 0 || minLen < 0 || minLen > maxLen {
+		panic("fcrand: ReadVariadic requires count >= 0 and 0 <= minLen <= maxLen")
+	}
+	lengths := make([]int, count)
+	total := 0
+	for i := range lengths {
+		lengths[i] = minLen + int(uint64n(uint64(maxLen-minLen)+1))
+		total += lengths[i]
+	}
+	backing := make([]byte, total)
+	Read(backing)
+	bufs := make([][]byte, count)
+	for i, n := range lengths {
+		bufs[i], backing = backing[:n:n], backing[n:]
+	}
+	return bufs
+}
+
+// ReadWithChecksum returns n random bytes followed by a checksum byte chosen so that the
+// sum of all n+1 bytes is 0 mod 256, eg. for self-consistent test frames.
+// It panics if n < 0.
+func ReadWithChecksum(n int) []byte {
+	b := make([]byte, n+1)
+	Read(b[:n])
+	var sum byte
+	for _, c := range b[:n] {
+		sum += c
+	}
+	b[n] = -sum
+	return b
+}
@@ -0,0 +1,124 @@
+package fcrand
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+)
+
+// Test ReadNonZero leaves no zero bytes and is roughly uniform over 1-255
+func TestReadNonZero(t *testing.T) {
+	ReadNonZero(nil)
+
+	const perValue = 400
+	b := make([]byte, 255*perValue)
+	ReadNonZero(b)
+
+	var counts [256]int
+	for _, c := range b {
+		counts[c]++
+	}
+	if counts[0] != 0 {
+		t.Fatalf("ReadNonZero left %d zero bytes", counts[0])
+	}
+	for v := 1; v < 256; v++ {
+		if counts[v] < perValue/2 || counts[v] > perValue*2 {
+			t.Fatalf("value %d occurred %d times, want about %d", v, counts[v], perValue)
+		}
+	}
+}
+
+// Test ReadHashed digest matches recomputing it over the returned bytes
+func TestReadHashed(t *testing.T) {
+	h := sha256.New()
+	h.Write([]byte("stale state")) // must be discarded by Reset
+	b, digest := ReadHashed(48, h)
+	if len(b) != 48 || bytes.Equal(b, make([]byte, 48)) {
+		t.Fatalf("ReadHashed returned bytes %x", b)
+	}
+	if want := sha256.Sum256(b); !bytes.Equal(digest, want[:]) {
+		t.Fatalf("ReadHashed digest %x, want %x", digest, want)
+	}
+}
+
+// Test ReadLengthPrefixed length range and content
+func TestReadLengthPrefixed(t *testing.T) {
+	if b := ReadLengthPrefixed(0); len(b) != 0 {
+		t.Fatalf("ReadLengthPrefixed(0) returned %d bytes", len(b))
+	}
+
+	seen := map[int]bool{}
+	for range 1000 {
+		b := ReadLengthPrefixed(5)
+		if len(b) > 5 {
+			t.Fatalf("ReadLengthPrefixed(5) returned %d bytes", len(b))
+		}
+		seen[len(b)] = true
+	}
+	if len(seen) != 6 {
+		t.Fatalf("ReadLengthPrefixed(5) produced %d distinct lengths, want 6", len(seen))
+	}
+
+	for range 10 {
+		if b := ReadLengthPrefixed(4096); len(b) >= 64 && bytes.Equal(b, make([]byte, len(b))) {
+			t.Fatalf("ReadLengthPrefixed returned %d zero bytes", len(b))
+		}
+	}
+}
+
+// Test ReadVariadic slice lengths and content
+func TestReadVariadic(t *testing.T) {
+	bufs := ReadVariadic(200, 8, 40)
+	if len(bufs) != 200 {
+		t.Fatalf("ReadVariadic returned %d slices, want 200", len(bufs))
+	}
+	lengths := map[int]bool{}
+	for i, s := range bufs {
+		if len(s) < 8 || len(s) > 40 {
+			t.Fatalf("slice %d has length %d, want in [8, 40]", i, len(s))
+		}
+		if bytes.Equal(s, make([]byte, len(s))) {
+			t.Fatalf("slice %d is all zero bytes", i)
+		}
+		lengths[len(s)] = true
+	}
+	if len(lengths) < 20 {
+		t.Fatalf("ReadVariadic produced only %d distinct lengths", len(lengths))
+	}
+
+	for _, s := range ReadVariadic(5, 3, 3) {
+		if len(s) != 3 {
+			t.Fatalf("ReadVariadic(5, 3, 3) returned slice of length %d", len(s))
+		}
+	}
+	if s := ReadVariadic(0, 0, 10); len(s) != 0 {
+		t.Fatalf("ReadVariadic(0, 0, 10) returned %d slices", len(s))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ReadVariadic with minLen > maxLen did not panic")
+		}
+	}()
+	ReadVariadic(1, 5, 4)
+}
+
+// Test ReadWithChecksum sums to 0 mod 256
+func TestReadWithChecksum(t *testing.T) {
+	for _, n := range []int{0, 1, 31, 200} {
+		b := ReadWithChecksum(n)
+		if len(b) != n+1 {
+			t.Fatalf("ReadWithChecksum(%d) returned %d bytes", n, len(b))
+		}
+		var sum byte
+		for _, c := range b {
+			sum += c
+		}
+		if sum != 0 {
+			t.Fatalf("ReadWithChecksum(%d) sums to %d mod 256", n, sum)
+		}
+		if n >= 16 && bytes.Equal(b[:n], make([]byte, n)) {
+			t.Fatalf("ReadWithChecksum(%d) payload is all zero bytes", n)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package fcrand
+
+import (
+	"errors"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// regexMaxRepeat is the number of repetitions beyond the minimum that FromRegex may
+// generate for unbounded repeats such as x*, x+ and x{n,}.
+const regexMaxRepeat = 8
+
+var errFromRegexUnsupported = errors.New("fcrand: FromRegex pattern uses an unsupported construct")
+
+// FromRegex returns a random string matched in full by the regular expression pattern
+// (in the syntax of package regexp), eg. for fuzzing validators.
+// Literals, character classes, ., alternation, grouping and the repetition operators
+// *, +, ? and {n,m} are supported, and each choice is drawn from the cache.
+// Unbounded repetitions are limited to 8 more than their minimum, and . generates
+// printable ASCII. ^ and $ are only supported at the very start and end of the pattern.
+// FromRegex returns the parse error for an invalid pattern, and an error for unsupported
+// constructs such as word boundaries, interior anchors and character classes that
+// match nothing.
+func FromRegex(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+	re = trimRegexAnchors(re.Simplify())
+	var sb strings.Builder
+	if err := generateRegex(&sb, re); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// trimRegexAnchors returns re without a leading start anchor and a trailing end anchor,
+// which match the start and end of every generated string.
+func trimRegexAnchors(re *syntax.Regexp) *syntax.Regexp {
+	isBegin := func(re *syntax.Regexp) bool { return re.Op == syntax.OpBeginText || re.Op == syntax.OpBeginLine }
+	isEnd := func(re *syntax.Regexp) bool { return re.Op == syntax.OpEndText || re.Op == syntax.OpEndLine }
+	switch {
+	case isBegin(re) || isEnd(re):
+		return &syntax.Regexp{Op: syntax.OpEmptyMatch}
+	case re.Op == syntax.OpConcat:
+		sub := re.Sub
+		if len(sub) > 0 && isBegin(sub[0]) {
+			sub = sub[1:]
+		}
+		if len(sub) > 0 && isEnd(sub[len(sub)-1]) {
+			sub = sub[:len(sub)-1]
+		}
+		return &syntax.Regexp{Op: syntax.OpConcat, Sub: sub}
+	}
+	return re
+}
+
+// generateRegex appends a random string matching re to sb.
+func generateRegex(sb *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 {
+				r = randomCaseFold(r)
+			}
+			sb.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		r, ok := randomClassRune(re.Rune)
+		if !ok {
+			return errFromRegexUnsupported
+		}
+		sb.WriteRune(r)
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteByte(printableASCII[uint64n(uint64(len(printableASCII)))])
+	case syntax.OpCapture:
+		return generateRegex(sb, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := generateRegex(sb, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		return generateRegex(sb, re.Sub[uint64n(uint64(len(re.Sub)))])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		lo, hi := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			lo, hi = 0, -1
+		case syntax.OpPlus:
+			lo, hi = 1, -1
+		case syntax.OpQuest:
+			lo, hi = 0, 1
+		}
+		if hi < 0 {
+			hi = lo + regexMaxRepeat
+		}
+		for range lo + int(uint64n(uint64(hi-lo)+1)) {
+			if err := generateRegex(sb, re.Sub[0]); err != nil {
+				return err
+			}
+		}
+	default: // OpNoMatch, interior anchors and word boundaries
+		return errFromRegexUnsupported
+	}
+	return nil
+}
+
+// randomClassRune returns a uniform random non-surrogate rune from the character class
+// ranges (pairs of inclusive bounds), or false if the class has no such rune.
+func randomClassRune(ranges []rune) (rune, bool) {
+	var total uint64
+	for i := 0; i < len(ranges); i += 2 {
+		total += nonSurrogateCount(ranges[i], ranges[i+1])
+	}
+	if total == 0 {
+		return 0, false
+	}
+	k := uint64n(total)
+	for i := 0; ; i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if n := nonSurrogateCount(lo, hi); k >= n {
+			k -= n
+			continue
+		}
+		return RuneIn(lo, hi), true // uniform within the range chosen with weight n/total
+	}
+}
+
+// nonSurrogateCount returns the number of non-surrogate runes in [lo, hi].
+func nonSurrogateCount(lo, hi rune) uint64 {
+	n := uint64(hi-lo) + 1
+	if skipStart, skipEnd := max(lo, surrogateMin), min(hi, surrogateMax); skipStart <= skipEnd {
+		n -= uint64(skipEnd-skipStart) + 1
+	}
+	return n
+}
+
+// randomCaseFold returns a uniform random rune from the simple case folding orbit of r,
+// eg. 'k', 'K' or the Kelvin sign for 'k'.
+func randomCaseFold(r rune) rune {
+	orbit := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		orbit = append(orbit, f)
+	}
+	return orbit[uint64n(uint64(len(orbit)))]
+}
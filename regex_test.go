@@ -0,0 +1,59 @@
+package fcrand
+
+import (
+	"regexp"
+	"testing"
+	"unicode/utf8"
+)
+
+// Test FromRegex output is matched in full by the pattern
+func TestFromRegex(t *testing.T) {
+	for _, pattern := range []string{
+		``,
+		`abc`,
+		`[a-z]+@[a-z]{2,5}\.com`,
+		`(foo|bar)?baz*`,
+		`\d{3}-\d{4}`,
+		`[^a-z]{5}`,
+		`(?i)hello`,
+		`.{0,10}`,
+		`\w+\s\W`,
+		`\p{Greek}+`,
+		`^[0-9a-f]{8}$`,
+		`(a|b|)c{2,}`,
+		`x{3}y{0,2}`,
+		`[\x{D000}-\x{E000}]`,
+	} {
+		full := regexp.MustCompile(`^(?:` + pattern + `)$`)
+		for range 200 {
+			s, err := FromRegex(pattern)
+			if err != nil {
+				t.Fatalf("FromRegex(%q): %v", pattern, err)
+			}
+			if !utf8.ValidString(s) {
+				t.Fatalf("FromRegex(%q) = %q is not valid UTF-8", pattern, s)
+			}
+			if !full.MatchString(s) {
+				t.Fatalf("FromRegex(%q) = %q does not match", pattern, s)
+			}
+		}
+	}
+
+	lengths := map[int]bool{}
+	for range 500 {
+		s, _ := FromRegex(`a*`)
+		lengths[len(s)] = true
+	}
+	if len(lengths) != regexMaxRepeat+1 {
+		t.Fatalf("FromRegex(`a*`) produced %d distinct lengths, want %d", len(lengths), regexMaxRepeat+1)
+	}
+
+	for _, pattern := range []string{`\bword\b`, `a^b`, `a$b`, `[^\x00-\x{10FFFF}]`} {
+		if _, err := FromRegex(pattern); err != errFromRegexUnsupported {
+			t.Fatalf("FromRegex(%q) error = %v, want %v", pattern, err, errFromRegexUnsupported)
+		}
+	}
+	if _, err := FromRegex(`a(b`); err == nil {
+		t.Fatal("FromRegex with an invalid pattern did not fail")
+	}
+}
@@ -0,0 +1,39 @@
+package fcrand
+
+import "slices"
+
+// Reservoir uniformly samples up to k items from a stream of unknown length
+// (reservoir sampling, Algorithm R). Replacement decisions are drawn from the cache.
+// A Reservoir is not safe for concurrent use.
+type Reservoir[T any] struct {
+	k     int
+	seen  uint64
+	items []T
+}
+
+// NewReservoir returns a Reservoir holding a uniform sample of up to k items.
+// It panics if k <= 0.
+func NewReservoir[T any](k int) *Reservoir[T] {
+	if k <= 0 {
+		panic("fcrand: reservoir size must be positive")
+	}
+	return &Reservoir[T]{k: k, items: make([]T, 0, k)}
+}
+
+// Add offers item to the reservoir. After n calls to Add, each item added
+// is in the sample with probability min(1, k/n).
+func (r *Reservoir[T]) Add(item T) {
+	r.seen++
+	if len(r.items) < r.k {
+		r.items = append(r.items, item)
+		return
+	}
+	if j := uint64n(r.seen); j < uint64(r.k) {
+		r.items[j] = item
+	}
+}
+
+// Sample returns a copy of the current sample.
+func (r *Reservoir[T]) Sample() []T {
+	return slices.Clone(r.items)
+}
@@ -0,0 +1,36 @@
+package fcrand
+
+import (
+	"math"
+	"testing"
+)
+
+// Test Reservoir inclusion probability is k/n for each item
+func TestReservoir(t *testing.T) {
+	const k, n, trials = 5, 20, 4000
+	var counts [n]int
+	for range trials {
+		r := NewReservoir[int](k)
+		for i := range n {
+			r.Add(i)
+		}
+		sample := r.Sample()
+		if len(sample) != k {
+			t.Fatalf("Sample returned %d items, want %d", len(sample), k)
+		}
+		for _, v := range sample {
+			counts[v]++
+		}
+	}
+	for i, c := range counts {
+		if p := float64(c) / trials; math.Abs(p-float64(k)/n) > 0.04 {
+			t.Fatalf("item %d inclusion probability %.3f, want %.3f", i, p, float64(k)/n)
+		}
+	}
+
+	r := NewReservoir[string](3)
+	r.Add("a")
+	if s := r.Sample(); len(s) != 1 || s[0] != "a" {
+		t.Fatalf("Sample after one Add = %v, want [a]", s)
+	}
+}
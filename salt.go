@@ -0,0 +1,17 @@
+package fcrand
+
+// SaltSize is the length in bytes of the salt returned by Salt.
+// 16 bytes (128 bits) is the commonly recommended minimum for password hashing.
+const SaltSize = 16
+
+// Salt returns a new random 16-byte salt, eg. for password hashing.
+func Salt() []byte {
+	return SaltN(SaltSize)
+}
+
+// SaltN returns a new random salt of n bytes. It panics if n < 0.
+func SaltN(n int) []byte {
+	salt := make([]byte, n)
+	Read(salt)
+	return salt
+}
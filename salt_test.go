@@ -0,0 +1,26 @@
+package fcrand
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Test Salt and SaltN lengths and content
+func TestSalt(t *testing.T) {
+	salt := Salt()
+	if len(salt) != 16 {
+		t.Fatalf("Salt returned %d bytes, want 16", len(salt))
+	}
+	if bytes.Equal(salt, make([]byte, 16)) {
+		t.Fatal("Salt returned all zero bytes")
+	}
+	if bytes.Equal(salt, Salt()) {
+		t.Fatal("Salt returned the same salt twice")
+	}
+
+	for _, n := range []int{0, 8, 32, 1024} {
+		if s := SaltN(n); len(s) != n || (n > 0 && bytes.Equal(s, make([]byte, n))) {
+			t.Fatalf("SaltN(%d) returned %d bytes: %x", n, len(s), s)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package fcrand
+
+import (
+	"errors"
+	"slices"
+)
+
+var errDistinctInts = errors.New("fcrand: DistinctInts requires 0 <= n <= max")
+
+// DistinctInts returns n distinct uniform random integers from [0, max), in random order.
+// Dense selections (n ≥ max/4) use a partial Fisher–Yates shuffle of [0, max);
+// sparse ones use rejection against a set of already-chosen values.
+// It returns an error unless 0 <= n <= max.
+func DistinctInts(n, max int) ([]int, error) {
+	if n < 0 || n > max {
+		return nil, errDistinctInts
+	}
+	if n >= max/4 {
+		pool := make([]int, max)
+		for i := range pool {
+			pool[i] = i
+		}
+		for i := range n {
+			j := i + int(uint64n(uint64(max-i)))
+			pool[i], pool[j] = pool[j], pool[i]
+		}
+		return pool[:n:n], nil
+	}
+
+	result := make([]int, 0, n)
+	chosen := make(map[int]struct{}, n)
+	for len(result) < n {
+		v := int(uint64n(uint64(max)))
+		if _, dup := chosen[v]; !dup {
+			chosen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// SampleWithReplacement returns k elements chosen independently and uniformly from s,
+// so duplicates may occur, eg. for bootstrap resampling.
+// It panics if s is empty or k < 0.
+func SampleWithReplacement[T any](s []T, k int) []T {
+	if len(s) == 0 {
+		panic("fcrand: SampleWithReplacement requires a non-empty slice")
+	}
+	if k < 0 {
+		panic("fcrand: SampleWithReplacement requires k >= 0")
+	}
+	sample := make([]T, k)
+	for i := range sample {
+		sample[i] = s[uint64n(uint64(len(s)))]
+	}
+	return sample
+}
+
+// SortedInts returns n independent uniform random integers from [0, max) in ascending
+// order, eg. for testing search and range-query code. Values may repeat; use DistinctInts
+// and sort the result for distinct values. It panics if n < 0 or max <= 0.
+func SortedInts(n, max int) []int {
+	if n < 0 || max <= 0 {
+		panic("fcrand: SortedInts requires n >= 0 and max > 0")
+	}
+	result := make([]int, n)
+	for i := range result {
+		result[i] = int(uint64n(uint64(max)))
+	}
+	slices.Sort(result)
+	return result
+}
@@ -0,0 +1,101 @@
+package fcrand
+
+import (
+	"slices"
+	"testing"
+)
+
+// Test DistinctInts results are distinct and in range for sparse and dense cases
+func TestDistinctInts(t *testing.T) {
+	for _, tc := range [][2]int{{0, 0}, {0, 10}, {5, 1000000}, {100, 1000}, {250, 1000}, {1000, 1000}, {1, 1}} {
+		n, max := tc[0], tc[1]
+		ints, err := DistinctInts(n, max)
+		if err != nil {
+			t.Fatalf("DistinctInts(%d, %d) returned error: %v", n, max, err)
+		}
+		if len(ints) != n {
+			t.Fatalf("DistinctInts(%d, %d) returned %d ints", n, max, len(ints))
+		}
+		seen := map[int]bool{}
+		for _, v := range ints {
+			if v < 0 || v >= max || seen[v] {
+				t.Fatalf("DistinctInts(%d, %d) returned out-of-range or duplicate %d", n, max, v)
+			}
+			seen[v] = true
+		}
+	}
+
+	// every value is reachable in the dense path
+	counts := map[int]int{}
+	for range 1000 {
+		ints, _ := DistinctInts(2, 4)
+		counts[ints[0]]++
+	}
+	if len(counts) != 4 {
+		t.Fatalf("DistinctInts(2, 4) first values %v, want all of 0..3", counts)
+	}
+
+	for _, tc := range [][2]int{{11, 10}, {-1, 10}} {
+		if _, err := DistinctInts(tc[0], tc[1]); err == nil {
+			t.Fatalf("DistinctInts(%d, %d) returned no error", tc[0], tc[1])
+		}
+	}
+}
+
+// Test SampleWithReplacement returns members of s, with duplicates
+func TestSampleWithReplacement(t *testing.T) {
+	s := []string{"a", "b", "c"}
+	sample := SampleWithReplacement(s, 30)
+	if len(sample) != 30 {
+		t.Fatalf("SampleWithReplacement returned %d elements, want 30", len(sample))
+	}
+	counts := map[string]int{}
+	for _, v := range sample {
+		if v != "a" && v != "b" && v != "c" {
+			t.Fatalf("SampleWithReplacement returned non-member %q", v)
+		}
+		counts[v]++
+	}
+	if len(counts) == len(sample) {
+		t.Fatal("SampleWithReplacement returned no duplicates")
+	}
+	if sample := SampleWithReplacement([]int{7}, 0); len(sample) != 0 {
+		t.Fatalf("SampleWithReplacement(k=0) returned %d elements", len(sample))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SampleWithReplacement on an empty slice did not panic")
+		}
+	}()
+	SampleWithReplacement([]int{}, 1)
+}
+
+// Test SortedInts length, order and range
+func TestSortedInts(t *testing.T) {
+	for _, tc := range [][2]int{{0, 1}, {1, 1}, {10, 3}, {1000, 1000}, {1000, 1 << 40}} {
+		n, max := tc[0], tc[1]
+		ints := SortedInts(n, max)
+		if len(ints) != n {
+			t.Fatalf("SortedInts(%d, %d) returned %d values", n, max, len(ints))
+		}
+		if !slices.IsSorted(ints) {
+			t.Fatalf("SortedInts(%d, %d) is not sorted", n, max)
+		}
+		for _, v := range ints {
+			if v < 0 || v >= max {
+				t.Fatalf("SortedInts(%d, %d) returned out-of-range %d", n, max, v)
+			}
+		}
+	}
+	if ints := SortedInts(1000, 1000); ints[0] > 50 || ints[999] < 950 {
+		t.Fatalf("SortedInts(1000, 1000) spans only [%d, %d]", ints[0], ints[999])
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SortedInts with max 0 did not panic")
+		}
+	}()
+	SortedInts(1, 0)
+}
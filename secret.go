@@ -0,0 +1,87 @@
+package fcrand
+
+import (
+	cryptoRand "crypto/rand"
+	"io"
+	"os"
+	"runtime"
+	"unsafe"
+)
+
+// Secret is a byte slice holding generated secret material.
+// A Secret can be used anywhere a []byte is expected.
+// Call Wipe to zero the bytes as soon as the secret is no longer needed.
+type Secret []byte
+
+// NewSecret returns a Secret of n cryptographically secure random bytes.
+// The bytes are read directly from crypto/rand, bypassing the cache, so that no copy
+// of the secret remains in the pooled cache buffers after Wipe.
+//
+// As a best-effort measure, a finalizer is registered which zeroes the backing
+// memory once it becomes unreachable. Finalizers are not guaranteed to run
+// (eg. at program exit, or for tiny allocations batched by the runtime), and
+// copies of the bytes made elsewhere are not wiped, so callers should still
+// call Wipe explicitly.
+func NewSecret(n int) Secret {
+	s := make(Secret, n)
+	cryptoRand.Read(s) // not fill, which replays a fixed block under fcrand_bench
+	if n > 0 {
+		runtime.SetFinalizer(&s[0], func(p *byte) {
+			clear(unsafe.Slice(p, n))
+		})
+	}
+	return s
+}
+
+// Wipe zeroes all bytes of s.
+func (s Secret) Wipe() {
+	clear(s)
+}
+
+// secretReader serves a fixed amount of random data through an internal buffer
+// which is wiped on Close.
+type secretReader struct {
+	remaining int    // bytes left to serve
+	buf       []byte // internal buffer
+	avail     int    // count of unserved bytes at the end of buf
+	closed    bool
+}
+
+// NewSecretReader returns a reader serving exactly n cryptographically secure random
+// bytes, followed by io.EOF, eg. for streaming key material into a consumer.
+// The bytes are read directly from crypto/rand, bypassing the cache, into an internal
+// buffer which Close zeroes, limiting how long secret material lingers in memory. Reads after Close return os.ErrClosed.
+// The returned reader is not safe for concurrent use. It panics if n < 0.
+func NewSecretReader(n int) io.ReadCloser {
+	if n < 0 {
+		panic("fcrand: NewSecretReader requires n >= 0")
+	}
+	return &secretReader{remaining: n, buf: make([]byte, min(n, maxBytesToFillViaCache))}
+}
+
+func (r *secretReader) Read(b []byte) (n int, err error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	for n < len(b) && r.remaining > 0 {
+		if r.avail == 0 {
+			r.avail = min(r.remaining, len(r.buf))
+			cryptoRand.Read(r.buf[len(r.buf)-r.avail:])
+		}
+		c := copy(b[n:], r.buf[len(r.buf)-r.avail:])
+		r.avail -= c
+		r.remaining -= c
+		n += c
+	}
+	if n == 0 && len(b) > 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// Close zeroes the internal buffer. It always returns nil.
+func (r *secretReader) Close() error {
+	clear(r.buf)
+	r.closed = true
+	return nil
+}
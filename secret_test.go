@@ -0,0 +1,99 @@
+package fcrand
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"runtime"
+	"testing"
+)
+
+// Test NewSecret returns random bytes usable as []byte, and Wipe zeroes them
+func TestSecret(t *testing.T) {
+	s := NewSecret(32)
+	if len(s) != 32 {
+		t.Fatalf("NewSecret returned length %d, want 32", len(s))
+	}
+	var b []byte = s
+	if bytes.Equal(b, make([]byte, 32)) {
+		t.Fatal("NewSecret returned all zero bytes")
+	}
+
+	s.Wipe()
+	if !bytes.Equal(b, make([]byte, 32)) {
+		t.Fatal("Wipe did not zero the bytes")
+	}
+
+	if len(NewSecret(0)) != 0 {
+		t.Fatal("NewSecret(0) returned non-empty Secret")
+	}
+	runtime.GC() // exercise the finalizer path
+}
+
+// Test NewSecretReader serves n bytes and Close zeroes its buffer
+func TestNewSecretReader(t *testing.T) {
+	r := NewSecretReader(1000)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll returned error: %v", err)
+	}
+	if len(data) != 1000 {
+		t.Fatalf("ReadAll returned %d bytes, want 1000", len(data))
+	}
+	assertHighEntropy(t, data)
+
+	sr := r.(*secretReader)
+	if bytes.Equal(sr.buf, make([]byte, len(sr.buf))) {
+		t.Fatal("internal buffer is zero before Close")
+	}
+	if err = r.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+	if !bytes.Equal(sr.buf, make([]byte, len(sr.buf))) {
+		t.Fatal("Close did not zero the internal buffer")
+	}
+	for _, size := range []int{16, 64} { // small and large buffer sizes
+		if cachesContain(data[len(data)-size:]) {
+			t.Fatalf("served bytes found in the pooled cache after Close (%d-byte window)", size)
+		}
+	}
+	if _, err = r.Read(make([]byte, 1)); !errors.Is(err, os.ErrClosed) {
+		t.Fatalf("Read after Close returned err=%v, want os.ErrClosed", err)
+	}
+
+	if n, err := NewSecretReader(0).Read(make([]byte, 8)); n != 0 || err != io.EOF {
+		t.Fatalf("Read from empty reader returned n=%d, err=%v", n, err)
+	}
+}
+
+// cachesContain reports whether any cache obtained from the pool holds b in either buffer.
+// It drains several caches before returning them, so it inspects the one most recently
+// used on this P as well as any others the pool hands out.
+func cachesContain(b []byte) bool {
+	var caches []*cache
+	defer func() {
+		for _, c := range caches {
+			cachePool.Put(c)
+		}
+	}()
+	for range 8 {
+		c := cachePool.Get().(*cache)
+		caches = append(caches, c)
+		if bytes.Contains(c.sb, b) || bytes.Contains(c.lb, b) {
+			return true
+		}
+	}
+	return false
+}
+
+// Test NewSecret bytes never pass through the pooled cache
+func TestSecret_BypassesCache(t *testing.T) {
+	for _, n := range []int{16, 64} { // small and large buffer sizes
+		s := NewSecret(n)
+		if cachesContain(s) {
+			t.Fatalf("NewSecret(%d) bytes found in the pooled cache", n)
+		}
+		s.Wipe()
+	}
+}
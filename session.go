@@ -0,0 +1,15 @@
+package fcrand
+
+// SessionIDSize is the length in bytes of the session ID returned by SessionID.
+// 32 bytes is the maximum (and typical) TLS session ID length.
+const SessionIDSize = 32
+
+// SessionID returns a new random 32-byte session ID, eg. for session management.
+func SessionID() []byte {
+	return SaltN(SessionIDSize)
+}
+
+// SessionIDHex returns a new random 32-byte session ID as 64 lowercase hex characters.
+func SessionIDHex() string {
+	return randomHex(SessionIDSize)
+}
@@ -0,0 +1,30 @@
+package fcrand
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test SessionID and SessionIDHex lengths and content
+func TestSessionID(t *testing.T) {
+	id := SessionID()
+	if len(id) != 32 {
+		t.Fatalf("SessionID returned %d bytes, want 32", len(id))
+	}
+	if bytes.Equal(id, make([]byte, 32)) {
+		t.Fatal("SessionID returned all zero bytes")
+	}
+	if bytes.Equal(id, SessionID()) {
+		t.Fatal("SessionID returned the same ID twice")
+	}
+
+	s := SessionIDHex()
+	b, err := hex.DecodeString(s)
+	if err != nil || len(s) != 64 {
+		t.Fatalf("SessionIDHex returned %q: %v", s, err)
+	}
+	if bytes.Equal(b, make([]byte, 32)) {
+		t.Fatal("SessionIDHex returned all zero bytes")
+	}
+}
@@ -0,0 +1,28 @@
+package fcrand
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errShamirParams = errors.New("fcrand: ShamirCoeffs requires 0 <= secret < prime and threshold >= 1")
+
+// ShamirCoeffs returns the threshold coefficients of a random Shamir's Secret Sharing
+// polynomial of degree threshold-1 over GF(prime): coeffs[0] is a copy of secret and
+// coeffs[1:] are threshold-1 uniform random values in [0, prime), drawn with IntMax.
+// It returns an error unless 0 <= secret < prime and threshold >= 1.
+func ShamirCoeffs(secret *big.Int, threshold int, prime *big.Int) ([]*big.Int, error) {
+	if threshold < 1 || secret.Sign() < 0 || secret.Cmp(prime) >= 0 {
+		return nil, errShamirParams
+	}
+	coeffs := make([]*big.Int, threshold)
+	coeffs[0] = new(big.Int).Set(secret)
+	for i := 1; i < threshold; i++ {
+		c, err := IntMax(prime)
+		if err != nil {
+			return nil, err
+		}
+		coeffs[i] = c
+	}
+	return coeffs, nil
+}
@@ -0,0 +1,38 @@
+package fcrand
+
+import (
+	"math/big"
+	"testing"
+)
+
+// Test ShamirCoeffs length, constant term and coefficient range
+func TestShamirCoeffs(t *testing.T) {
+	prime, _ := new(big.Int).SetString("170141183460469231731687303715884105727", 10) // 2^127 - 1
+	secret := big.NewInt(123456789)
+	for _, threshold := range []int{1, 2, 5} {
+		coeffs, err := ShamirCoeffs(secret, threshold, prime)
+		if err != nil {
+			t.Fatalf("ShamirCoeffs(threshold=%d) returned error: %v", threshold, err)
+		}
+		if len(coeffs) != threshold {
+			t.Fatalf("ShamirCoeffs(threshold=%d) returned %d coefficients", threshold, len(coeffs))
+		}
+		if coeffs[0].Cmp(secret) != 0 || coeffs[0] == secret {
+			t.Fatalf("constant term %v is not a copy of the secret", coeffs[0])
+		}
+		for i, c := range coeffs[1:] {
+			if c.Sign() < 0 || c.Cmp(prime) >= 0 {
+				t.Fatalf("coefficient %d = %v out of range", i+1, c)
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		secret    *big.Int
+		threshold int
+	}{{secret, 0}, {prime, 3}, {big.NewInt(-1), 3}} {
+		if _, err := ShamirCoeffs(tc.secret, tc.threshold, prime); err == nil {
+			t.Fatalf("ShamirCoeffs(%v, %d) returned no error", tc.secret, tc.threshold)
+		}
+	}
+}
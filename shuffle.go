@@ -0,0 +1,43 @@
+package fcrand
+
+// ShuffleBytes performs an unbiased in-place Fisher–Yates shuffle of b.
+// Swap indices are drawn from the cache.
+func ShuffleBytes(b []byte) {
+	for i := len(b) - 1; i > 0; i-- {
+		j := uint64n(uint64(i + 1))
+		b[i], b[j] = b[j], b[i]
+	}
+}
+
+// PermuteInto writes a uniformly random permutation of s into dst without modifying s,
+// using the "inside-out" Fisher–Yates shuffle with indices drawn from the cache.
+// It panics if len(dst) != len(s). s and dst must not overlap.
+func PermuteInto[T any](s []T, dst []T) {
+	if len(dst) != len(s) {
+		panic("fcrand: PermuteInto requires len(dst) == len(s)")
+	}
+	for i := range s {
+		j := uint64n(uint64(i + 1))
+		dst[i] = dst[j]
+		dst[j] = s[i]
+	}
+}
+
+// RandomSBox returns a uniformly random permutation of all 256 byte values,
+// ie. a random bijective substitution box. See InvertSBox.
+func RandomSBox() (sbox [256]byte) {
+	for i := range sbox {
+		sbox[i] = byte(i)
+	}
+	ShuffleBytes(sbox[:])
+	return sbox
+}
+
+// InvertSBox returns the inverse of sbox, so that inv[sbox[x]] == x for every byte x.
+// sbox must be a permutation, such as one returned by RandomSBox.
+func InvertSBox(sbox [256]byte) (inv [256]byte) {
+	for i, v := range sbox {
+		inv[v] = byte(i)
+	}
+	return inv
+}
@@ -0,0 +1,84 @@
+package fcrand
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+// Test ShuffleBytes preserves the byte multiset
+func TestShuffleBytes(t *testing.T) {
+	ShuffleBytes(nil)
+	ShuffleBytes([]byte{42})
+
+	orig := make([]byte, 256)
+	for i := range orig {
+		orig[i] = byte(i)
+	}
+	b := slices.Clone(orig)
+	ShuffleBytes(b)
+
+	sorted := slices.Clone(b)
+	slices.Sort(sorted)
+	if !bytes.Equal(sorted, orig) {
+		t.Fatal("ShuffleBytes did not preserve the byte multiset")
+	}
+	if bytes.Equal(b, orig) {
+		t.Fatal("ShuffleBytes left 256 bytes in original order")
+	}
+}
+
+// Test PermuteInto writes a permutation of s into dst and leaves s unchanged
+func TestPermuteInto(t *testing.T) {
+	s := make([]int, 100)
+	for i := range s {
+		s[i] = i
+	}
+	orig := slices.Clone(s)
+	dst := make([]int, len(s))
+	PermuteInto(s, dst)
+
+	if !slices.Equal(s, orig) {
+		t.Fatal("PermuteInto modified s")
+	}
+	if slices.Equal(dst, s) {
+		t.Fatal("PermuteInto left 100 elements in original order")
+	}
+	slices.Sort(dst)
+	if !slices.Equal(dst, s) {
+		t.Fatal("PermuteInto did not produce a permutation of s")
+	}
+
+	PermuteInto([]string{}, []string{})
+	defer func() {
+		if recover() == nil {
+			t.Fatal("PermuteInto with mismatched lengths did not panic")
+		}
+	}()
+	PermuteInto([]int{1, 2}, make([]int, 1))
+}
+
+// Test RandomSBox is a permutation and InvertSBox inverts it
+func TestRandomSBox(t *testing.T) {
+	sbox := RandomSBox()
+	var seen [256]bool
+	for _, v := range sbox {
+		if seen[v] {
+			t.Fatalf("RandomSBox repeated value %d", v)
+		}
+		seen[v] = true
+	}
+	if sbox == RandomSBox() {
+		t.Fatal("RandomSBox returned the same permutation twice")
+	}
+
+	inv := InvertSBox(sbox)
+	for x := range 256 {
+		if got := inv[sbox[x]]; got != byte(x) {
+			t.Fatalf("inv[sbox[%d]] = %d", x, got)
+		}
+		if got := sbox[inv[x]]; got != byte(x) {
+			t.Fatalf("sbox[inv[%d]] = %d", x, got)
+		}
+	}
+}
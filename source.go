@@ -0,0 +1,10 @@
+//go:build !fcrand_bench
+
+package fcrand
+
+import cryptoRand "crypto/rand"
+
+// fill fills b from crypto/rand. It is the only source of random data for Read.
+func fill(b []byte) {
+	cryptoRand.Read(b) // guaranteed not to fail since Go 1.24
+}
@@ -0,0 +1,22 @@
+//go:build fcrand_bench
+
+package fcrand
+
+import cryptoRand "crypto/rand"
+
+// benchSource is a fixed block of random bytes replayed by fill.
+var benchSource = func() []byte {
+	b := make([]byte, lbByteSize)
+	cryptoRand.Read(b)
+	return b
+}()
+
+// fill replaces crypto/rand with a fast in-memory source under the fcrand_bench build tag,
+// so that benchmarks measure only the buffering overhead of Read, isolated from the
+// crypto/rand syscall cost. It replays benchSource and is NOT random:
+// the fcrand_bench tag is for benchmarking only and must never be used in production.
+func fill(b []byte) {
+	for len(b) > 0 {
+		b = b[copy(b, benchSource):]
+	}
+}
@@ -0,0 +1,26 @@
+package fcrand
+
+import "context"
+
+// Uint64Stream returns a channel with capacity buf which a background goroutine keeps
+// filled with uniform random uint64 values. The values are generated in batches.
+// The goroutine exits and closes the channel once ctx is done; callers must cancel
+// ctx when they stop receiving, or the goroutine leaks. It panics if buf < 0.
+func Uint64Stream(ctx context.Context, buf int) <-chan uint64 {
+	ch := make(chan uint64, buf)
+	go func() {
+		defer close(ch)
+		var batch [64]uint64
+		for {
+			ReadUint64s(batch[:])
+			for _, v := range batch {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch
+}
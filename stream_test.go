@@ -0,0 +1,34 @@
+package fcrand
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Test Uint64Stream yields distinct values and closes on cancel
+func TestUint64Stream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := Uint64Stream(ctx, 16)
+
+	seen := map[uint64]bool{}
+	for range 200 {
+		seen[<-ch] = true
+	}
+	if len(seen) != 200 {
+		t.Fatalf("Uint64Stream yielded %d distinct values out of 200", len(seen))
+	}
+
+	cancel()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("Uint64Stream channel not closed after cancel")
+		}
+	}
+}
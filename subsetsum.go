@@ -0,0 +1,23 @@
+package fcrand
+
+import "math/big"
+
+// SubsetSumInstance returns a random subset-sum problem instance for testing and benchmarking
+// solvers: n weights uniform in [0, 2^bits), drawn via Reader, and a target equal to the
+// sum of a uniformly random subset of them, so the instance always has a solution.
+// It panics if n < 0 or bits < 1.
+func SubsetSumInstance(n, bits int) (weights []*big.Int, target *big.Int) {
+	if n < 0 || bits < 1 {
+		panic("fcrand: SubsetSumInstance requires n >= 0 and bits >= 1")
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	weights = make([]*big.Int, n)
+	target = new(big.Int)
+	for i, inSubset := range Flips(n, 0.5) {
+		weights[i], _ = IntMax(limit) // Reader never fails
+		if inSubset {
+			target.Add(target, weights[i])
+		}
+	}
+	return weights, target
+}
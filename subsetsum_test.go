@@ -0,0 +1,41 @@
+package fcrand
+
+import (
+	"math/big"
+	"testing"
+)
+
+// Test SubsetSumInstance targets are always achievable
+func TestSubsetSumInstance(t *testing.T) {
+	const n, bits = 10, 20
+	limit := new(big.Int).Lsh(big.NewInt(1), bits)
+	for range 20 {
+		weights, target := SubsetSumInstance(n, bits)
+		if len(weights) != n {
+			t.Fatalf("SubsetSumInstance returned %d weights, want %d", len(weights), n)
+		}
+		for _, w := range weights {
+			if w.Sign() < 0 || w.Cmp(limit) >= 0 {
+				t.Fatalf("weight %v out of range", w)
+			}
+		}
+
+		achievable := false
+		for mask := 0; mask < 1<<n && !achievable; mask++ {
+			sum := new(big.Int)
+			for i, w := range weights {
+				if mask&(1<<i) != 0 {
+					sum.Add(sum, w)
+				}
+			}
+			achievable = sum.Cmp(target) == 0
+		}
+		if !achievable {
+			t.Fatalf("target %v is not a subset sum of %v", target, weights)
+		}
+	}
+
+	if weights, target := SubsetSumInstance(0, 8); len(weights) != 0 || target.Sign() != 0 {
+		t.Fatalf("SubsetSumInstance(0, 8) = %v, %v", weights, target)
+	}
+}
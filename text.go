@@ -0,0 +1,240 @@
+package fcrand
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+)
+
+const (
+	surrogateMin = 0xD800
+	surrogateMax = 0xDFFF
+
+	lowerAlphanumeric = "abcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// randomString returns n characters drawn uniformly from alphabet, which must be
+// non-empty ASCII with at most 256 characters. See fillFromAlphabet.
+func randomString(alphabet string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	dst := make([]byte, n)
+	fillFromAlphabet(dst, alphabet)
+	return unsafe.String(&dst[0], n)
+}
+
+// fillFromAlphabet fills dst with characters drawn uniformly from alphabet, which must be
+// non-empty ASCII with at most 256 characters. Random bytes are read directly into dst
+// and rejection sampled in place to avoid modulo bias; only the rejected tail is re-read.
+func fillFromAlphabet(dst []byte, alphabet string) {
+	limit := 256 - 256%len(alphabet) // largest multiple of len(alphabet) <= 256
+	for filled := 0; filled < len(dst); {
+		r := dst[filled:]
+		Read(r)
+		for _, b := range r { // writes to dst[filled] never overtake the unread part of r
+			if int(b) < limit {
+				dst[filled] = alphabet[int(b)%len(alphabet)]
+				filled++
+			}
+		}
+	}
+}
+
+// UTF8String returns a string of runeCount uniformly random Unicode code points
+// from [0, 0x10FFFF], excluding the surrogate range [0xD800, 0xDFFF].
+// The result is always valid UTF-8, and is useful for fuzzing text handling with
+// multibyte input. UTF8String returns "" if runeCount <= 0.
+func UTF8String(runeCount int) string {
+	const validCount = utf8.MaxRune + 1 - (surrogateMax - surrogateMin + 1)
+	var sb strings.Builder
+	sb.Grow(max(runeCount, 0) * utf8.UTFMax)
+	for range runeCount {
+		r := rune(uint64n(validCount))
+		if r >= surrogateMin {
+			r += surrogateMax - surrogateMin + 1
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// emailLocalLength is the length of the random local part generated by Email.
+const emailLocalLength = 10
+
+// Email returns a syntactically valid random email address at the reserved
+// "example.test" domain, eg. "a7bf3kq09z@example.test", for generating test fixtures.
+func Email() string {
+	return EmailAt("example.test")
+}
+
+// EmailAt returns a random email address at domain. The local part consists of
+// lowercase letters and digits only, so it never requires quoting.
+func EmailAt(domain string) string {
+	return randomString(lowerAlphanumeric, emailLocalLength) + "@" + domain
+}
+
+const (
+	slugConsonants    = "bcdfghjklmnprstvwz"
+	slugVowels        = "aeiou"
+	slugSegmentLength = 5 // consonant-vowel-consonant-vowel-consonant
+)
+
+// Slug returns segments hyphen-separated pronounceable lowercase words of alternating
+// consonants and vowels, eg. "bakor-timul-zevon", for human-friendly unique identifiers.
+// Each segment carries about 17 bits of randomness. Slug returns "" if segments <= 0.
+func Slug(segments int) string {
+	if segments <= 0 {
+		return ""
+	}
+	b := make([]byte, 0, segments*(slugSegmentLength+1))
+	for i := range segments {
+		if i > 0 {
+			b = append(b, '-')
+		}
+		for j := range slugSegmentLength {
+			letters := slugConsonants
+			if j%2 == 1 {
+				letters = slugVowels
+			}
+			b = append(b, letters[uint64n(uint64(len(letters)))])
+		}
+	}
+	return string(b)
+}
+
+// radixDigits holds the digits used by RadixString, in order of value.
+const radixDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+var errRadix = errors.New("fcrand: radix must be in [2, 36]")
+
+// RadixString returns a string of length uniformly random digits in the given radix,
+// using the digits 0-9a-z, eg. binary strings (radix 2) or base-36 IDs.
+// It returns an error if radix is not in [2, 36]. RadixString returns "" if length <= 0.
+func RadixString(radix, length int) (string, error) {
+	if radix < 2 || radix > len(radixDigits) {
+		return "", errRadix
+	}
+	return randomString(radixDigits[:radix], length), nil
+}
+
+// SemVer returns a random semantic version "X.Y.Z" with each component uniform in
+// [0, maxMajor], [0, maxMinor] and [0, maxPatch] respectively.
+// It panics if any bound is negative.
+func SemVer(maxMajor, maxMinor, maxPatch int) string {
+	if maxMajor < 0 || maxMinor < 0 || maxPatch < 0 {
+		panic("fcrand: SemVer bounds must be non-negative")
+	}
+	b := strconv.AppendUint(nil, uint64n(uint64(maxMajor)+1), 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, uint64n(uint64(maxMinor)+1), 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, uint64n(uint64(maxPatch)+1), 10)
+	return string(b)
+}
+
+// RuneIn returns a uniform random code point in [lo, hi], skipping the surrogate range
+// [0xD800, 0xDFFF], eg. for generating test characters from a specific script.
+// It panics unless 0 <= lo <= hi <= utf8.MaxRune and [lo, hi] contains a non-surrogate.
+func RuneIn(lo, hi rune) rune {
+	if lo < 0 || lo > hi || hi > utf8.MaxRune {
+		panic("fcrand: RuneIn requires 0 <= lo <= hi <= utf8.MaxRune")
+	}
+	count := uint64(hi-lo) + 1
+	skipStart, skipEnd := max(lo, surrogateMin), min(hi, surrogateMax)
+	skip := uint64(0)
+	if skipStart <= skipEnd {
+		skip = uint64(skipEnd-skipStart) + 1
+	}
+	if skip == count {
+		panic("fcrand: RuneIn range contains only surrogates")
+	}
+	r := lo + rune(uint64n(count-skip))
+	if skip > 0 && r >= skipStart {
+		r += rune(skip)
+	}
+	return r
+}
+
+var errNoRepeatAlphabet = errors.New("fcrand: alphabet must contain at least 2 distinct characters")
+
+// NoRepeatText returns n characters drawn uniformly from alphabet such that no two adjacent
+// characters are identical, for human-readable codes that look less "clumpy".
+// A character equal to its predecessor is redrawn, so each character after the first is
+// uniform over the rest of the alphabet. It returns an error unless alphabet contains
+// at least 2 distinct characters. NoRepeatText returns "" if n <= 0.
+func NoRepeatText(n int, alphabet string) (string, error) {
+	chars := []rune(alphabet)
+	distinct := false
+	for _, c := range chars[min(1, len(chars)):] {
+		distinct = distinct || c != chars[0]
+	}
+	if !distinct {
+		return "", errNoRepeatAlphabet
+	}
+
+	var sb strings.Builder
+	sb.Grow(max(n, 0) * utf8.RuneLen(chars[0]))
+	prev := rune(-1)
+	for range n {
+		c := chars[uint64n(uint64(len(chars)))]
+		for c == prev {
+			c = chars[uint64n(uint64(len(chars)))]
+		}
+		sb.WriteRune(c)
+		prev = c
+	}
+	return sb.String(), nil
+}
+
+// printableASCII holds the 95 printable ASCII characters 0x20-0x7E, in order.
+const printableASCII = " !\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~"
+
+// Printable returns n characters drawn uniformly from the 95 printable ASCII characters
+// (0x20-0x7E, including space), eg. for fuzzing text fields which must stay printable.
+// Rejection sampling avoids modulo bias. Printable returns "" if n <= 0.
+func Printable(n int) string {
+	return randomString(printableASCII, n)
+}
+
+// RandomLines writes lineCount newline-terminated lines of random printable ASCII
+// (see Printable) to w, eg. for generating large test input files. Each line has a
+// uniformly random length in [0, maxLineLen], excluding the newline. A single buffer is
+// reused for all lines, and each line is one w.Write. The first write error is returned.
+// It panics if lineCount < 0 or maxLineLen < 0.
+func RandomLines(w io.Writer, lineCount, maxLineLen int) error {
+	if lineCount < 0 || maxLineLen < 0 {
+		panic("fcrand: RandomLines requires lineCount >= 0 and maxLineLen >= 0")
+	}
+	buf := make([]byte, maxLineLen+1)
+	for range lineCount {
+		n := int(uint64n(uint64(maxLineLen) + 1))
+		fillFromAlphabet(buf[:n], printableASCII)
+		buf[n] = '\n'
+		if _, err := w.Write(buf[:n+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dnsLabelMaxLength is the maximum length of a DNS label (RFC 1035).
+const dnsLabelMaxLength = 63
+
+// DNSLabel returns a random valid DNS label (RFC 1123 hostname label syntax) of
+// uniformly random length in [1, maxLen], eg. for fuzzing hostname parsing.
+// Labels are lowercase letters, digits and hyphens, may start with a digit as RFC 1123
+// allows, and never start or end with a hyphen. It panics unless 1 <= maxLen <= 63.
+func DNSLabel(maxLen int) string {
+	if maxLen < 1 || maxLen > dnsLabelMaxLength {
+		panic("fcrand: DNSLabel requires 1 <= maxLen <= 63")
+	}
+	b := make([]byte, 1+uint64n(uint64(maxLen)))
+	fillFromAlphabet(b, lowerAlphanumeric+"-")
+	fillFromAlphabet(b[:1], lowerAlphanumeric)
+	fillFromAlphabet(b[len(b)-1:], lowerAlphanumeric)
+	return unsafe.String(&b[0], len(b))
+}
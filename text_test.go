@@ -0,0 +1,314 @@
+package fcrand
+
+import (
+	"bytes"
+	"errors"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// Test UTF8String returns valid UTF-8 with the requested rune count
+func TestUTF8String(t *testing.T) {
+	for _, n := range []int{0, 1, 10, 1000} {
+		s := UTF8String(n)
+		if !utf8.ValidString(s) {
+			t.Fatalf("UTF8String(%d) returned invalid UTF-8", n)
+		}
+		if c := utf8.RuneCountInString(s); c != n {
+			t.Fatalf("UTF8String(%d) returned %d runes", n, c)
+		}
+	}
+	if s := UTF8String(-1); s != "" {
+		t.Fatalf("UTF8String(-1) = %q, want empty", s)
+	}
+}
+
+// Test randomString length, charset and uniformity
+func TestRandomString(t *testing.T) {
+	if s := randomString("abc", 0); s != "" {
+		t.Fatalf("randomString(abc, 0) = %q", s)
+	}
+	const n = 30000
+	s := randomString("abc", n)
+	if len(s) != n {
+		t.Fatalf("randomString returned length %d, want %d", len(s), n)
+	}
+	for _, c := range "abc" {
+		if k := strings.Count(s, string(c)); k < n/3*9/10 || k > n/3*11/10 {
+			t.Fatalf("char %q occurred %d times, want about %d", c, k, n/3)
+		}
+	}
+}
+
+// Test Email and EmailAt parse as addresses
+func TestEmail(t *testing.T) {
+	for _, addr := range []string{Email(), EmailAt("mail.example.com")} {
+		parsed, err := mail.ParseAddress(addr)
+		if err != nil {
+			t.Fatalf("ParseAddress(%q) returned error: %v", addr, err)
+		}
+		if parsed.Address != addr {
+			t.Fatalf("ParseAddress(%q) = %q", addr, parsed.Address)
+		}
+		local, _, _ := strings.Cut(addr, "@")
+		if len(local) != emailLocalLength || strings.Trim(local, lowerAlphanumeric) != "" {
+			t.Fatalf("Email returned invalid local part %q", local)
+		}
+	}
+	if !strings.HasSuffix(Email(), "@example.test") {
+		t.Fatal("Email did not use the example.test domain")
+	}
+}
+
+// Test Slug segment count and charset
+func TestSlug(t *testing.T) {
+	for _, n := range []int{1, 3, 8} {
+		s := Slug(n)
+		segments := strings.Split(s, "-")
+		if len(segments) != n {
+			t.Fatalf("Slug(%d) = %q has %d segments", n, s, len(segments))
+		}
+		for _, seg := range segments {
+			if len(seg) != slugSegmentLength || strings.Trim(seg, "abcdefghijklmnopqrstuvwxyz") != "" {
+				t.Fatalf("Slug(%d) = %q has invalid segment %q", n, s, seg)
+			}
+		}
+	}
+	if s := Slug(0); s != "" {
+		t.Fatalf("Slug(0) = %q, want empty", s)
+	}
+}
+
+// Test RadixString charset and length for several radixes
+func TestRadixString(t *testing.T) {
+	for _, radix := range []int{2, 16, 36} {
+		s, err := RadixString(radix, 200)
+		if err != nil {
+			t.Fatalf("RadixString(%d) returned error: %v", radix, err)
+		}
+		if len(s) != 200 {
+			t.Fatalf("RadixString(%d, 200) returned length %d", radix, len(s))
+		}
+		digits := radixDigits[:radix]
+		if strings.Trim(s, digits) != "" {
+			t.Fatalf("RadixString(%d) = %q has digits outside %q", radix, s, digits)
+		}
+		if radix == 2 && (!strings.Contains(s, "0") || !strings.Contains(s, "1")) {
+			t.Fatalf("RadixString(2, 200) = %q is missing a digit", s)
+		}
+	}
+	for _, radix := range []int{0, 1, 37} {
+		if _, err := RadixString(radix, 10); err == nil {
+			t.Fatalf("RadixString(%d) returned no error", radix)
+		}
+	}
+}
+
+// semVerCore matches a semantic version core per https://semver.org
+var semVerCore = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)$`)
+
+// Test SemVer output is valid and within bounds
+func TestSemVer(t *testing.T) {
+	bounds := [3]int{3, 0, 12}
+	for range 500 {
+		v := SemVer(bounds[0], bounds[1], bounds[2])
+		m := semVerCore.FindStringSubmatch(v)
+		if m == nil {
+			t.Fatalf("SemVer returned invalid version %q", v)
+		}
+		for i, bound := range bounds {
+			if c, _ := strconv.Atoi(m[i+1]); c > bound {
+				t.Fatalf("SemVer returned %q, component %d exceeds %d", v, i, bound)
+			}
+		}
+	}
+}
+
+// Test RuneIn stays in range and never returns surrogates
+func TestRuneIn(t *testing.T) {
+	ranges := [][2]rune{{'a', 'z'}, {0x0400, 0x04FF}, {0xD7F0, 0xE010}, {0xDC00, 0xE000}, {0xD000, 0xD800}, {'x', 'x'}, {0, utf8.MaxRune}}
+	for _, r := range ranges {
+		seen := map[rune]bool{}
+		for range 2000 {
+			c := RuneIn(r[0], r[1])
+			if c < r[0] || c > r[1] {
+				t.Fatalf("RuneIn(%#x, %#x) = %#x out of range", r[0], r[1], c)
+			}
+			if c >= surrogateMin && c <= surrogateMax {
+				t.Fatalf("RuneIn(%#x, %#x) returned surrogate %#x", r[0], r[1], c)
+			}
+			seen[c] = true
+		}
+		if r == [2]rune{0xDC00, 0xE000} && (len(seen) != 1 || !seen[0xE000]) {
+			t.Fatalf("RuneIn(0xDC00, 0xE000) returned %v, want only 0xE000", seen)
+		}
+		if r == [2]rune{'a', 'z'} && len(seen) != 26 {
+			t.Fatalf("RuneIn('a', 'z') produced %d distinct runes, want 26", len(seen))
+		}
+	}
+
+	for _, r := range [][2]rune{{'z', 'a'}, {-1, 'a'}, {0, utf8.MaxRune + 1}, {0xD800, 0xDFFF}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("RuneIn(%#x, %#x) did not panic", r[0], r[1])
+				}
+			}()
+			RuneIn(r[0], r[1])
+		}()
+	}
+}
+
+// Test NoRepeatText length and absence of adjacent repeats
+func TestNoRepeatText(t *testing.T) {
+	for _, alphabet := range []string{"ab", "ABCDEFGHJKMNPQRSTUVWXYZ23456789", "αβγ"} {
+		s, err := NoRepeatText(500, alphabet)
+		if err != nil {
+			t.Fatalf("NoRepeatText(%q) returned error: %v", alphabet, err)
+		}
+		runes := []rune(s)
+		if len(runes) != 500 {
+			t.Fatalf("NoRepeatText(500, %q) returned %d characters", alphabet, len(runes))
+		}
+		for i, r := range runes {
+			if !strings.ContainsRune(alphabet, r) {
+				t.Fatalf("NoRepeatText(%q) returned character %q", alphabet, r)
+			}
+			if i > 0 && r == runes[i-1] {
+				t.Fatalf("NoRepeatText(%q) repeated %q at %d", alphabet, r, i)
+			}
+		}
+	}
+	if s, err := NoRepeatText(0, "ab"); s != "" || err != nil {
+		t.Fatalf("NoRepeatText(0) = %q, %v", s, err)
+	}
+	for _, alphabet := range []string{"", "a", "aaa"} {
+		if _, err := NoRepeatText(5, alphabet); err == nil {
+			t.Fatalf("NoRepeatText(%q) returned no error", alphabet)
+		}
+	}
+}
+
+// Test Printable length and character range
+func TestPrintable(t *testing.T) {
+	if len(printableASCII) != 95 {
+		t.Fatalf("printableASCII has %d characters, want 95", len(printableASCII))
+	}
+	for i := range printableASCII {
+		if printableASCII[i] != byte(0x20+i) {
+			t.Fatalf("printableASCII[%d] = %q, want %q", i, printableASCII[i], byte(0x20+i))
+		}
+	}
+
+	s := Printable(5000)
+	if len(s) != 5000 {
+		t.Fatalf("Printable(5000) returned length %d", len(s))
+	}
+	seen := map[byte]bool{}
+	for i := range len(s) {
+		if s[i] < 0x20 || s[i] > 0x7E {
+			t.Fatalf("Printable returned non-printable byte %#x", s[i])
+		}
+		seen[s[i]] = true
+	}
+	if len(seen) != 95 {
+		t.Fatalf("Printable(5000) used %d distinct characters, want 95", len(seen))
+	}
+}
+
+// failingWriter accepts n successful writes, then fails every write with err.
+type failingWriter struct {
+	n   int
+	err error
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.n <= 0 {
+		return 0, w.err
+	}
+	w.n--
+	return len(p), nil
+}
+
+// Test RandomLines line count, line lengths, charset and error propagation
+func TestRandomLines(t *testing.T) {
+	const lineCount, maxLineLen = 1000, 80
+	var buf bytes.Buffer
+	if err := RandomLines(&buf, lineCount, maxLineLen); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.HasSuffix(out, "\n") {
+		t.Fatal("RandomLines output is not newline-terminated")
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) != lineCount {
+		t.Fatalf("RandomLines wrote %d lines, want %d", len(lines), lineCount)
+	}
+	lengths := map[int]bool{}
+	for _, line := range lines {
+		if len(line) > maxLineLen {
+			t.Fatalf("line length %d exceeds %d", len(line), maxLineLen)
+		}
+		for i := range len(line) {
+			if line[i] < 0x20 || line[i] > 0x7E {
+				t.Fatalf("RandomLines wrote non-printable byte %#x", line[i])
+			}
+		}
+		lengths[len(line)] = true
+	}
+	if len(lengths) < maxLineLen/2 {
+		t.Fatalf("RandomLines used only %d distinct line lengths", len(lengths))
+	}
+
+	buf.Reset()
+	if err := RandomLines(&buf, 0, maxLineLen); err != nil || buf.Len() != 0 {
+		t.Fatalf("RandomLines(0 lines) wrote %d bytes, err %v", buf.Len(), err)
+	}
+	if err := RandomLines(&buf, 5, 0); err != nil || buf.String() != "\n\n\n\n\n" {
+		t.Fatalf("RandomLines(maxLineLen 0) = %q, err %v", buf.String(), err)
+	}
+
+	errWrite := errors.New("write failed")
+	w := &failingWriter{n: 3, err: errWrite}
+	if err := RandomLines(w, 10, maxLineLen); err != errWrite {
+		t.Fatalf("RandomLines error = %v, want %v", err, errWrite)
+	}
+}
+
+// Test DNSLabel output follows DNS label rules and covers the length range
+func TestDNSLabel(t *testing.T) {
+	label := regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	for _, maxLen := range []int{1, 2, 10, 63} {
+		lengths := map[int]bool{}
+		sawHyphen := false
+		for range 100 * maxLen {
+			s := DNSLabel(maxLen)
+			if len(s) < 1 || len(s) > maxLen {
+				t.Fatalf("DNSLabel(%d) returned length %d", maxLen, len(s))
+			}
+			if !label.MatchString(s) {
+				t.Fatalf("DNSLabel(%d) = %q is not a valid label", maxLen, s)
+			}
+			lengths[len(s)] = true
+			sawHyphen = sawHyphen || strings.Contains(s, "-")
+		}
+		if len(lengths) != maxLen {
+			t.Fatalf("DNSLabel(%d) produced %d distinct lengths", maxLen, len(lengths))
+		}
+		if maxLen > 2 && !sawHyphen {
+			t.Fatalf("DNSLabel(%d) never produced a hyphen", maxLen)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("DNSLabel(64) did not panic")
+		}
+	}()
+	DNSLabel(64)
+}
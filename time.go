@@ -0,0 +1,77 @@
+package fcrand
+
+import (
+	"math"
+	"time"
+)
+
+// Backoff returns a full-jitter exponential backoff delay for the given retry attempt
+// (starting at 0): a uniform random duration in [0, min(max, base*2^attempt)].
+// The exponent is capped so that large attempts do not overflow.
+// Backoff returns 0 if base or max is not positive; a negative attempt is treated as 0.
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	if base <= 0 || max <= 0 {
+		return 0
+	}
+	ceiling := max
+	if attempt = min(attempt, 62); attempt < 0 {
+		attempt = 0
+	}
+	if base <= max>>attempt {
+		ceiling = base << attempt
+	}
+	return time.Duration(uint64n(uint64(ceiling) + 1))
+}
+
+// TimeBetween returns a uniform random instant in [start, end), with nanosecond resolution,
+// in the location of start. It panics unless end is after start.
+func TimeBetween(start, end time.Time) time.Time {
+	if !end.After(start) {
+		panic("fcrand: TimeBetween requires end after start")
+	}
+	if span := end.Sub(start); span < math.MaxInt64 {
+		return start.Add(time.Duration(uint64n(uint64(span))))
+	}
+
+	// The span exceeds time.Duration (~292 years): draw whole seconds and nanoseconds
+	// separately, which is uniform over [start, start+secs+1s), and reject past end.
+	secs := uint64(end.Unix()-start.Unix()) + 1
+	for {
+		t := time.Unix(start.Unix()+int64(uint64n(secs)), int64(start.Nanosecond())+int64(uint64n(1e9)))
+		if t.Before(end) {
+			return t.In(start.Location())
+		}
+	}
+}
+
+// Date returns a uniform random calendar date at midnight UTC between January 1 of minYear
+// and December 31 of maxYear inclusive. Every day in the range is equally likely,
+// so leap years and month lengths are accounted for. It panics if minYear > maxYear.
+func Date(minYear, maxYear int) time.Time {
+	if minYear > maxYear {
+		panic("fcrand: Date requires minYear <= maxYear")
+	}
+	const secondsPerDay = 24 * 60 * 60
+	first := time.Date(minYear, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+	end := time.Date(maxYear+1, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+	day := int64(uint64n(uint64((end - first) / secondsPerDay)))
+	return time.Unix(first+day*secondsPerDay, 0).UTC()
+}
+
+// DurationNormal returns a duration sampled from a normal distribution with the given mean
+// and standard deviation (see CryptoRand.NormFloat64), eg. for simulating latencies.
+// Negative samples are clamped to 0, so for means within a few standard deviations of 0
+// the sample mean exceeds mean. It panics if stddev < 0.
+func DurationNormal(mean, stddev time.Duration) time.Duration {
+	if stddev < 0 {
+		panic("fcrand: DurationNormal requires stddev >= 0")
+	}
+	d := float64(mean) + NewRand().NormFloat64()*float64(stddev)
+	switch {
+	case d <= 0:
+		return 0
+	case d >= math.MaxInt64:
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
@@ -0,0 +1,135 @@
+package fcrand
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// Test Backoff stays within [0, min(max, base*2^attempt)] and its ceiling grows
+func TestBackoff(t *testing.T) {
+	const base, max = 10 * time.Millisecond, 5 * time.Second
+	prevCeiling := time.Duration(0)
+	for attempt := range 80 {
+		ceiling := max
+		if attempt < 20 {
+			ceiling = min(max, base<<attempt)
+		}
+		exceededPrev := false
+		for range 200 {
+			d := Backoff(attempt, base, max)
+			if d < 0 || d > ceiling {
+				t.Fatalf("Backoff(%d) = %v, want in [0, %v]", attempt, d, ceiling)
+			}
+			exceededPrev = exceededPrev || d > prevCeiling
+		}
+		// while the ceiling doubles, half of all samples exceed the previous ceiling
+		if ceiling > prevCeiling && !exceededPrev {
+			t.Fatalf("Backoff(%d) never exceeded the previous ceiling %v", attempt, prevCeiling)
+		}
+		prevCeiling = ceiling
+	}
+
+	if d := Backoff(math.MaxInt, time.Duration(math.MaxInt64), time.Duration(math.MaxInt64)); d < 0 {
+		t.Fatalf("Backoff with huge inputs = %v", d)
+	}
+	if d := Backoff(-5, base, max); d > base {
+		t.Fatalf("Backoff(-5) = %v, want <= %v", d, base)
+	}
+	if d := Backoff(3, 0, max); d != 0 {
+		t.Fatalf("Backoff with zero base = %v, want 0", d)
+	}
+}
+
+// Test TimeBetween stays within the window without clustering
+func TestTimeBetween(t *testing.T) {
+	start := time.Date(2024, 2, 28, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	var buckets [10]int
+	for range 2000 {
+		v := TimeBetween(start, end)
+		if v.Before(start) || !v.Before(end) {
+			t.Fatalf("TimeBetween returned %v, want in [%v, %v)", v, start, end)
+		}
+		buckets[v.Sub(start)*10/time.Hour]++
+	}
+	for i, c := range buckets {
+		if c < 100 {
+			t.Fatalf("bucket %d has %d samples, want about 200", i, c)
+		}
+	}
+
+	start, end = time.Date(1000, 1, 1, 0, 0, 0, 5, time.UTC), time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	for range 100 {
+		if v := TimeBetween(start, end); v.Before(start) || !v.Before(end) {
+			t.Fatalf("TimeBetween returned %v, want in [%v, %v)", v, start, end)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("TimeBetween with end == start did not panic")
+		}
+	}()
+	TimeBetween(start, start)
+}
+
+// Test Date returns valid midnight UTC dates within range, including Feb 29
+func TestDate(t *testing.T) {
+	for range 2000 {
+		d := Date(1999, 2001)
+		if d.Year() < 1999 || d.Year() > 2001 {
+			t.Fatalf("Date(1999, 2001) = %v", d)
+		}
+		if d.Location() != time.UTC || d.Hour() != 0 || d.Minute() != 0 || d.Second() != 0 || d.Nanosecond() != 0 {
+			t.Fatalf("Date returned %v, want midnight UTC", d)
+		}
+		if d.Month() == time.February && d.Day() == 29 && d.Year() != 2000 {
+			t.Fatalf("Date returned Feb 29 of non-leap year %d", d.Year())
+		}
+	}
+
+	// a single leap year: all 366 days are reachable, including Feb 29 and Dec 31
+	seen := map[int]bool{}
+	for range 20000 {
+		seen[Date(2024, 2024).YearDay()] = true
+	}
+	if len(seen) != 366 {
+		t.Fatalf("Date(2024, 2024) produced %d distinct days, want 366", len(seen))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Date(2001, 2000) did not panic")
+		}
+	}()
+	Date(2001, 2000)
+}
+
+// Test DurationNormal sample mean and standard deviation, and clamping
+func TestDurationNormal(t *testing.T) {
+	const n, mean, stddev = 20000, 100 * time.Millisecond, 10 * time.Millisecond
+	var sum, sumSq float64
+	for range n {
+		d := float64(DurationNormal(mean, stddev))
+		sum += d
+		sumSq += d * d
+	}
+	gotMean := sum / n
+	gotStddev := math.Sqrt(sumSq/n - gotMean*gotMean)
+	if math.Abs(gotMean-float64(mean)) > 0.01*float64(mean) {
+		t.Fatalf("sample mean %v, want %v", time.Duration(gotMean), mean)
+	}
+	if math.Abs(gotStddev-float64(stddev)) > 0.05*float64(stddev) {
+		t.Fatalf("sample stddev %v, want %v", time.Duration(gotStddev), stddev)
+	}
+
+	for range 1000 {
+		if d := DurationNormal(0, time.Second); d < 0 {
+			t.Fatalf("DurationNormal returned negative %v", d)
+		}
+	}
+	if d := DurationNormal(time.Second, 0); d != time.Second {
+		t.Fatalf("DurationNormal with zero stddev = %v", d)
+	}
+}
@@ -0,0 +1,95 @@
+package fcrand
+
+import (
+	cryptoRand "crypto/rand"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// ErrTimeout is returned by a TimeoutReader when a buffer refill does not complete in time.
+var ErrTimeout = errors.New("fcrand: timed out waiting for random data")
+
+// TimeoutReader is a buffered random reader which bounds the time spent waiting on
+// crypto/rand, guarding latency-critical code against entropy stalls (eg. a blocked
+// getrandom(2) during early boot). Reads served from its buffer never wait;
+// only a refill (a cache miss) is bounded by the timeout.
+// A TimeoutReader is safe for concurrent use.
+type TimeoutReader struct {
+	src     io.Reader
+	timeout time.Duration
+
+	mu      sync.Mutex
+	buf     []byte
+	avail   int               // count of bytes available at the end of buf
+	pending chan refillResult // in-flight refill, if any
+}
+
+type refillResult struct {
+	buf []byte
+	err error
+}
+
+// NewTimeoutReader returns a TimeoutReader reading from crypto/rand, whose Read returns
+// ErrTimeout if a refill takes longer than timeout. It panics if timeout <= 0, since an
+// already expired timer would race the refill and make Read nondeterministic.
+func NewTimeoutReader(timeout time.Duration) *TimeoutReader {
+	return newTimeoutReader(cryptoRand.Reader, timeout)
+}
+
+func newTimeoutReader(src io.Reader, timeout time.Duration) *TimeoutReader {
+	if timeout <= 0 {
+		panic("fcrand: NewTimeoutReader requires a positive timeout")
+	}
+	return &TimeoutReader{src: src, timeout: timeout}
+}
+
+// Read fills b with random bytes. If a refill times out, Read returns the number of bytes
+// filled so far and ErrTimeout. A timed-out refill keeps running in the background
+// and is used by a subsequent Read once it completes.
+func (r *TimeoutReader) Read(b []byte) (n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for n < len(b) {
+		if r.avail == 0 {
+			if err = r.refill(); err != nil {
+				return n, err
+			}
+		}
+		c := copy(b[n:], r.buf[len(r.buf)-r.avail:])
+		r.avail -= c
+		n += c
+	}
+	return n, nil
+}
+
+// refill replaces the buffer with fresh random bytes, waiting at most r.timeout.
+// The source is read into a new buffer by a goroutine, since a timed-out read may
+// still be writing when refill returns.
+func (r *TimeoutReader) refill() error {
+	if r.pending == nil {
+		ch := make(chan refillResult, 1)
+		go func() {
+			buf := make([]byte, lbByteSize)
+			_, err := io.ReadFull(r.src, buf)
+			ch <- refillResult{buf, err}
+		}()
+		r.pending = ch
+	}
+
+	timer := time.NewTimer(r.timeout)
+	defer timer.Stop()
+	select {
+	case res := <-r.pending:
+		r.pending = nil
+		if res.err != nil {
+			return res.err
+		}
+		r.buf, r.avail = res.buf, len(res.buf)
+		return nil
+	case <-timer.C:
+		return ErrTimeout
+	}
+}
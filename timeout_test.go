@@ -0,0 +1,73 @@
+package fcrand
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// slowReader blocks each Read until release is closed
+type slowReader struct {
+	release chan struct{}
+}
+
+func (s slowReader) Read(b []byte) (int, error) {
+	<-s.release
+	return Reader.Read(b)
+}
+
+// Test TimeoutReader returns ErrTimeout for a slow source, then recovers
+func TestTimeoutReader(t *testing.T) {
+	src := slowReader{release: make(chan struct{})}
+	r := newTimeoutReader(src, 10*time.Millisecond)
+
+	buf := make([]byte, 32)
+	if n, err := r.Read(buf); n != 0 || !errors.Is(err, ErrTimeout) {
+		t.Fatalf("Read returned n=%d, err=%v, want 0, ErrTimeout", n, err)
+	}
+
+	close(src.release)
+	r.timeout = time.Second
+	if n, err := r.Read(buf); n != len(buf) || err != nil {
+		t.Fatalf("Read after release returned n=%d, err=%v", n, err)
+	}
+	if bytes.Equal(buf, make([]byte, 32)) {
+		t.Fatal("Read returned all zero bytes")
+	}
+}
+
+// Test TimeoutReader with crypto/rand across multiple refills
+func TestNewTimeoutReader(t *testing.T) {
+	r := NewTimeoutReader(time.Second)
+	buf := make([]byte, 3*lbByteSize+5)
+	if n, err := io.ReadFull(r, buf); n != len(buf) || err != nil {
+		t.Fatalf("ReadFull returned n=%d, err=%v", n, err)
+	}
+	if bytes.Equal(buf[len(buf)-64:], make([]byte, 64)) {
+		t.Fatal("Read returned all zero bytes")
+	}
+}
+
+// Test TimeoutReader propagates source errors
+func TestTimeoutReader_Error(t *testing.T) {
+	r := newTimeoutReader(bytes.NewReader(nil), time.Second)
+	if _, err := r.Read(make([]byte, 8)); !errors.Is(err, io.EOF) {
+		t.Fatalf("Read returned err=%v, want io.EOF", err)
+	}
+}
+
+// Test NewTimeoutReader rejects non-positive timeouts
+func TestNewTimeoutReader_NonPositive(t *testing.T) {
+	for _, timeout := range []time.Duration{0, -time.Second} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("NewTimeoutReader(%v) did not panic", timeout)
+				}
+			}()
+			NewTimeoutReader(timeout)
+		}()
+	}
+}
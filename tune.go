@@ -0,0 +1,77 @@
+package fcrand
+
+import (
+	cryptoRand "crypto/rand"
+	"math/bits"
+	"time"
+)
+
+const (
+	tuneRequestsPerRefill = 64      // target number of requests served per buffer refill
+	tuneMinBufferSize     = 256     // smallest recommended buffer size
+	tuneMaxBufferSize     = 1 << 16 // largest recommended buffer size
+	probeReadSize         = 16      // bytes per crypto/rand.Read issued by ProbeLatency
+)
+
+// RecommendBufferSize computes a recommended small and large buffer size (in bytes)
+// for the given sample of expected request sizes.
+//
+// Requests are classified the same way Read classifies them: sizes below 32 bytes use
+// the small buffer, sizes from 32 to 512 bytes use the large buffer (in 8-byte blocks),
+// and all other sizes bypass the cache and are ignored.
+// Each buffer is sized to serve about 64 average requests per refill, rounded up to a
+// power of 2 and clamped to [256, 65536] bytes. A buffer class with no sampled requests
+// gets the default size (1024 bytes small, 4096 bytes large).
+//
+// The result is advisory only: fcrand's buffer sizes are compile-time constants and
+// cannot be configured at run time, so the recommendation is meant for forks or
+// rebuilds that change those constants.
+func RecommendBufferSize(sizes []int) (small, large int) {
+	var sbTotal, sbCount, lbTotal, lbCount int
+	for _, n := range sizes {
+		switch {
+		case n <= 0 || n > maxBytesToFillViaCache:
+			continue
+		case n < sbCutoff:
+			sbTotal += n
+			sbCount++
+		default:
+			lbTotal += (n + lbBlockByteSize - 1) &^ (lbBlockByteSize - 1)
+			lbCount++
+		}
+	}
+
+	small, large = sbByteSize, lbByteSize
+	if sbCount > 0 {
+		small = recommendSize(sbTotal, sbCount, tuneMinBufferSize)
+	}
+	if lbCount > 0 {
+		large = recommendSize(lbTotal, lbCount, maxBytesToFillViaCache)
+	}
+	return small, large
+}
+
+// recommendSize returns the power-of-2 buffer size serving tuneRequestsPerRefill
+// requests of mean size total/count, clamped to [minSize, tuneMaxBufferSize].
+func recommendSize(total, count, minSize int) int {
+	want := (total*tuneRequestsPerRefill + count - 1) / count
+	size := 1 << bits.Len(uint(want-1)) // round up to a power of 2
+	return min(max(size, minSize), tuneMaxBufferSize)
+}
+
+// ProbeLatency returns the mean latency of a direct crypto/rand.Read of a 16-byte buffer,
+// measured over samples consecutive reads, to help decide whether fcrand's batching pays
+// off on the current platform (it helps most where the system random source is slow).
+// It bypasses the cache and performs real system calls or vDSO reads, so it takes
+// about samples times the returned duration. It panics if samples <= 0.
+func ProbeLatency(samples int) time.Duration {
+	if samples <= 0 {
+		panic("fcrand: ProbeLatency requires samples > 0")
+	}
+	var buf [probeReadSize]byte
+	start := time.Now()
+	for range samples {
+		cryptoRand.Read(buf[:])
+	}
+	return time.Since(start) / time.Duration(samples)
+}
@@ -0,0 +1,57 @@
+package fcrand
+
+import "testing"
+
+// Test RecommendBufferSize for small-skewed and large-skewed distributions
+func TestRecommendBufferSize(t *testing.T) {
+	small, large := RecommendBufferSize(nil)
+	if small != sbByteSize || large != lbByteSize {
+		t.Fatalf("RecommendBufferSize(nil) = %d, %d, want defaults %d, %d", small, large, sbByteSize, lbByteSize)
+	}
+
+	var skewedSmall []int
+	for i := range 1000 {
+		skewedSmall = append(skewedSmall, 4+i%4) // 4..7 bytes
+	}
+	skewedSmall = append(skewedSmall, 48)
+	small, large = RecommendBufferSize(skewedSmall)
+	if small != 512 { // mean 5.5 bytes * 64 = 352 -> 512
+		t.Fatalf("skewed small: small = %d, want 512", small)
+	}
+	if large != 4096 { // single 48-byte request * 64 = 3072 -> 4096
+		t.Fatalf("skewed small: large = %d, want 4096", large)
+	}
+
+	var skewedLarge []int
+	for range 1000 {
+		skewedLarge = append(skewedLarge, 500, 4096)
+	}
+	small, large = RecommendBufferSize(skewedLarge)
+	if small != sbByteSize {
+		t.Fatalf("skewed large: small = %d, want default %d", small, sbByteSize)
+	}
+	if large != 32768 { // 504 bytes * 64 = 32256 -> 32768
+		t.Fatalf("skewed large: large = %d, want 32768", large)
+	}
+
+	for _, sizes := range [][]int{{1}, {512}, {31, 32}} {
+		small, large = RecommendBufferSize(sizes)
+		if small < tuneMinBufferSize || large < maxBytesToFillViaCache || small > tuneMaxBufferSize || large > tuneMaxBufferSize {
+			t.Fatalf("RecommendBufferSize(%v) = %d, %d out of bounds", sizes, small, large)
+		}
+	}
+}
+
+// Test ProbeLatency returns a positive mean duration
+func TestProbeLatency(t *testing.T) {
+	if d := ProbeLatency(1000); d <= 0 {
+		t.Fatalf("ProbeLatency(1000) = %v, want > 0", d)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ProbeLatency(0) did not panic")
+		}
+	}()
+	ProbeLatency(0)
+}
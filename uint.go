@@ -0,0 +1,69 @@
+package fcrand
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"unsafe"
+)
+
+// randUint64 returns a uniform random uint64 drawn from the cache.
+func randUint64() uint64 {
+	var b [8]byte
+	Read(b[:])
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// uint64n returns an unbiased uniform random value in [0, n) drawn from the cache.
+// It uses Lemire's multiply-and-reject method. n must be > 0.
+func uint64n(n uint64) uint64 {
+	if n&(n-1) == 0 { // n is a power of 2
+		return randUint64() & (n - 1)
+	}
+	hi, lo := bits.Mul64(randUint64(), n)
+	if lo < n {
+		thresh := -n % n
+		for lo < thresh {
+			hi, lo = bits.Mul64(randUint64(), n)
+		}
+	}
+	return hi
+}
+
+// float64Unit returns a uniform random float64 in [0, 1) with 53 bits of precision,
+// drawn from the cache.
+func float64Unit() float64 {
+	return float64(randUint64()>>11) / (1 << 53)
+}
+
+// ReadUint64s fills dst with uniform random uint64 values.
+// It fills the bytes backing dst with a single Read of len(dst)*8 bytes rather than
+// drawing each value separately. Since every bit is random, the values are
+// uniform regardless of the platform byte order.
+func ReadUint64s(dst []uint64) {
+	if len(dst) == 0 {
+		return
+	}
+	Read(unsafe.Slice((*byte)(unsafe.Pointer(&dst[0])), len(dst)*8))
+}
+
+// IntInclusive returns a uniform random integer in [min, max], both inclusive.
+// Any range is supported, including [math.MinInt64, math.MaxInt64].
+// It panics if min > max.
+func IntInclusive(min, max int64) int64 {
+	if min > max {
+		panic("fcrand: IntInclusive requires min <= max")
+	}
+	span := uint64(max) - uint64(min) + 1 // wraps to 0 for the full int64 range
+	if span == 0 {
+		return int64(randUint64())
+	}
+	return int64(uint64(min) + uint64n(span))
+}
+
+// Seed returns a uniform random int64 drawn from the cache, for seeding a fast
+// non-cryptographic PRNG (eg. math/rand.NewSource) when its output must be unpredictable
+// but need not be secure. Unlike a time.Now().UnixNano() seed, it cannot be guessed
+// and does not collide across processes started at the same moment.
+func Seed() int64 {
+	return int64(randUint64())
+}
@@ -0,0 +1,75 @@
+package fcrand
+
+import (
+	"math"
+	"testing"
+)
+
+// Test uint64n stays within [0, n)
+func TestUint64n(t *testing.T) {
+	for _, n := range []uint64{1, 2, 3, 7, 8, 100, 1<<63 + 1} {
+		for range 1000 {
+			if v := uint64n(n); v >= n {
+				t.Fatalf("uint64n(%d) returned %d", n, v)
+			}
+		}
+	}
+}
+
+// Test ReadUint64s fills distinct non-zero values
+func TestReadUint64s(t *testing.T) {
+	ReadUint64s(nil)
+
+	dst := make([]uint64, 64)
+	ReadUint64s(dst)
+	seen := map[uint64]bool{}
+	for _, v := range dst {
+		seen[v] = true
+	}
+	if len(seen) != len(dst) || seen[0] {
+		t.Fatalf("ReadUint64s returned %d distinct values out of %d, zero present: %v", len(seen), len(dst), seen[0])
+	}
+}
+
+// Test IntInclusive reaches both endpoints uniformly and handles extreme ranges
+func TestIntInclusive(t *testing.T) {
+	const draws = 6000
+	var counts [7]int
+	for range draws {
+		v := IntInclusive(1, 6)
+		if v < 1 || v > 6 {
+			t.Fatalf("IntInclusive(1, 6) = %d", v)
+		}
+		counts[v]++
+	}
+	for v := 1; v <= 6; v++ {
+		if counts[v] < draws/6*3/4 || counts[v] > draws/6*5/4 {
+			t.Fatalf("value %d occurred %d times, want about %d", v, counts[v], draws/6)
+		}
+	}
+
+	if v := IntInclusive(-3, -3); v != -3 {
+		t.Fatalf("IntInclusive(-3, -3) = %d", v)
+	}
+	for range 100 {
+		if v := IntInclusive(math.MaxInt64-1, math.MaxInt64); v < math.MaxInt64-1 {
+			t.Fatalf("IntInclusive near MaxInt64 = %d", v)
+		}
+		if v := IntInclusive(math.MinInt64, math.MinInt64+1); v > math.MinInt64+1 {
+			t.Fatalf("IntInclusive near MinInt64 = %d", v)
+		}
+	}
+	IntInclusive(math.MinInt64, math.MaxInt64)
+}
+
+// Test Seed returns distinct values across calls
+func TestSeed(t *testing.T) {
+	seen := map[int64]bool{}
+	for range 1000 {
+		s := Seed()
+		if seen[s] {
+			t.Fatalf("Seed repeated value %d", s)
+		}
+		seen[s] = true
+	}
+}
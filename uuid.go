@@ -0,0 +1,47 @@
+package fcrand
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+var errUUIDVersion = errors.New("fcrand: unsupported UUID version")
+
+// UUID returns a new RFC 9562 UUID of the given version, with the version and variant
+// bits set accordingly. Supported versions are 4 (fully random) and 7 (Unix millisecond
+// timestamp followed by random bits, so UUIDs sort by creation time).
+// It returns an error for any other version.
+func UUID(version int) ([16]byte, error) {
+	switch version {
+	case 4:
+		return uuidV4(), nil
+	case 7:
+		return uuidV7(time.Now()), nil
+	default:
+		return [16]byte{}, errUUIDVersion
+	}
+}
+
+// uuidV4 returns a random version 4 UUID.
+func uuidV4() (u [16]byte) {
+	Read(u[:])
+	setUUIDVersion(&u, 4)
+	return u
+}
+
+// uuidV7 returns a version 7 UUID for the timestamp t.
+func uuidV7(t time.Time) (u [16]byte) {
+	Read(u[6:])
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(t.UnixMilli()))
+	copy(u[:6], ts[2:]) // 48-bit big-endian timestamp
+	setUUIDVersion(&u, 7)
+	return u
+}
+
+// setUUIDVersion sets the version nibble and the RFC 9562 variant bits of u.
+func setUUIDVersion(u *[16]byte, version byte) {
+	u[6] = u[6]&0x0F | version<<4
+	u[8] = u[8]&0x3F | 0x80
+}
@@ -0,0 +1,44 @@
+package fcrand
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// Test UUID version nibble and variant bits, and unsupported versions
+func TestUUID(t *testing.T) {
+	for _, version := range []int{4, 7} {
+		seen := map[[16]byte]bool{}
+		for range 100 {
+			u, err := UUID(version)
+			if err != nil {
+				t.Fatalf("UUID(%d) returned error: %v", version, err)
+			}
+			if v := int(u[6] >> 4); v != version {
+				t.Fatalf("UUID(%d) has version nibble %d", version, v)
+			}
+			if u[8]&0xC0 != 0x80 {
+				t.Fatalf("UUID(%d) has variant bits %02b", version, u[8]>>6)
+			}
+			seen[u] = true
+		}
+		if len(seen) != 100 {
+			t.Fatalf("UUID(%d) returned duplicates", version)
+		}
+	}
+
+	before := time.Now().UnixMilli()
+	u, _ := UUID(7)
+	var ts [8]byte
+	copy(ts[2:], u[:6])
+	if ms := int64(binary.BigEndian.Uint64(ts[:])); ms < before || ms > time.Now().UnixMilli() {
+		t.Fatalf("UUID(7) timestamp %d out of range", ms)
+	}
+
+	for _, version := range []int{0, 1, 3, 5, 6, 8} {
+		if _, err := UUID(version); err == nil {
+			t.Fatalf("UUID(%d) returned no error", version)
+		}
+	}
+}
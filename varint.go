@@ -0,0 +1,35 @@
+package fcrand
+
+import "encoding/binary"
+
+// Varint returns the LEB128 (protobuf-style) unsigned varint encoding of a random uint64,
+// eg. for fuzzing varint decoders. See VarintN.
+func Varint() []byte {
+	return VarintN(binary.MaxVarintLen64)
+}
+
+// VarintN returns the LEB128 unsigned varint encoding of a random uint64 whose encoding is
+// at most maxBytes long. The encoded length is uniform in [1, maxBytes], and the value is
+// uniform among those with that length, so short and long encodings are equally common
+// (a uniform uint64 would almost always encode to 9 or 10 bytes).
+// It panics unless 1 <= maxBytes <= binary.MaxVarintLen64.
+func VarintN(maxBytes int) []byte {
+	b, _ := varintN(maxBytes)
+	return b
+}
+
+// varintN returns a random varint encoding together with the encoded value.
+func varintN(maxBytes int) ([]byte, uint64) {
+	if maxBytes < 1 || maxBytes > binary.MaxVarintLen64 {
+		panic("fcrand: VarintN requires 1 <= maxBytes <= binary.MaxVarintLen64")
+	}
+	n := 1 + int(uint64n(uint64(maxBytes)))
+	bits := min(7*n, 64)
+	hi := ^uint64(0) >> (64 - bits) // largest value encoding to n bytes
+	lo := uint64(0)                 // smallest value encoding to n bytes
+	if n > 1 {
+		lo = 1 << (7 * (n - 1))
+	}
+	v := lo + uint64n(hi-lo+1)
+	return binary.AppendUvarint(make([]byte, 0, n), v), v
+}
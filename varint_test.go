@@ -0,0 +1,50 @@
+package fcrand
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// Test VarintN encodings decode to their value, respect maxBytes and cover every length
+func TestVarintN(t *testing.T) {
+	for maxBytes := 1; maxBytes <= binary.MaxVarintLen64; maxBytes++ {
+		lengths := map[int]bool{}
+		for range 200 * maxBytes {
+			b, v := varintN(maxBytes)
+			if len(b) > maxBytes {
+				t.Fatalf("varintN(%d) returned %d bytes", maxBytes, len(b))
+			}
+			got, n := binary.Uvarint(b)
+			if n != len(b) || got != v {
+				t.Fatalf("binary.Uvarint(%x) = %d, %d; want %d, %d", b, got, n, v, len(b))
+			}
+			for _, c := range b[:len(b)-1] {
+				if c&0x80 == 0 {
+					t.Fatalf("varint %x has a non-final byte without the continuation bit", b)
+				}
+			}
+			if b[len(b)-1]&0x80 != 0 {
+				t.Fatalf("varint %x final byte has the continuation bit", b)
+			}
+			lengths[len(b)] = true
+		}
+		if len(lengths) != maxBytes {
+			t.Fatalf("varintN(%d) produced %d distinct lengths", maxBytes, len(lengths))
+		}
+	}
+
+	if _, n := binary.Uvarint(Varint()); n <= 0 {
+		t.Fatal("Varint returned an undecodable encoding")
+	}
+
+	for _, maxBytes := range []int{0, binary.MaxVarintLen64 + 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("VarintN(%d) did not panic", maxBytes)
+				}
+			}()
+			VarintN(maxBytes)
+		}()
+	}
+}
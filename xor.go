@@ -0,0 +1,102 @@
+package fcrand
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"io"
+	"sync"
+	"unsafe"
+)
+
+// xorReader XORs equal-length reads from all of its sources.
+type xorReader struct {
+	sources []io.Reader
+
+	mu  sync.Mutex
+	buf []byte // scratch for reads from sources[1:]
+}
+
+// NewXORReader returns a reader whose output is the XOR of equal-length reads
+// from all sources. As long as the sources are independent, the combined output
+// is at least as strong as the strongest source, which allows mixing Reader with
+// a second entropy source (eg. a hardware RNG) for defense-in-depth.
+//
+// Each source is read with io.ReadFull semantics, so short reads are handled.
+// If any source fails to fill its share, Read returns 0 and the error.
+// The returned reader is safe for concurrent use if all sources are; Reads are
+// serialized so that the sources stay aligned.
+// NewXORReader panics if no sources are given.
+func NewXORReader(sources ...io.Reader) io.Reader {
+	if len(sources) == 0 {
+		panic("fcrand: NewXORReader requires at least one source")
+	}
+	return &xorReader{
+		sources: append([]io.Reader(nil), sources...), // defensive copy
+		buf:     make([]byte, maxBytesToFillViaCache),
+	}
+}
+
+func (x *xorReader) Read(b []byte) (int, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if _, err := io.ReadFull(x.sources[0], b); err != nil {
+		return 0, err
+	}
+	for _, src := range x.sources[1:] {
+		for chunk := b; len(chunk) > 0; {
+			tmp := x.buf[:min(len(chunk), len(x.buf))]
+			if _, err := io.ReadFull(src, tmp); err != nil {
+				return 0, err
+			}
+			subtle.XORBytes(chunk, chunk, tmp)
+			chunk = chunk[len(tmp):]
+		}
+	}
+	return len(b), nil
+}
+
+// NewStream returns a cipher.Stream whose XORKeyStream XORs src with fresh random
+// bytes from the cache, for one-time-pad style masking of small buffers.
+// The key stream is random rather than keyed: it is never reproducible, so the
+// output cannot be unmasked by another Stream and this is NOT a decryption primitive.
+// The returned Stream is safe for concurrent use.
+func NewStream() cipher.Stream {
+	return randStream{}
+}
+
+// XORRead XORs fresh cryptographically secure random bytes into the existing contents of b,
+// eg. to mix entropy into a buffer or mask existing data. On a zeroed b it is equivalent to Read.
+func XORRead(b []byte) {
+	randStream{}.XORKeyStream(b, b)
+}
+
+// randStream is a cipher.Stream with a random, non-reproducible key stream.
+type randStream struct{}
+
+// XORKeyStream follows the cipher.Stream rules: it panics if dst is shorter than src,
+// or if dst and src overlap other than exactly.
+func (randStream) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("fcrand: output smaller than input")
+	}
+	if inexactOverlap(dst[:len(src)], src) {
+		panic("fcrand: invalid buffer overlap")
+	}
+	var buf [maxBytesToFillViaCache]byte
+	for len(src) > 0 {
+		ks := buf[:min(len(src), len(buf))]
+		Read(ks)
+		subtle.XORBytes(dst, src, ks)
+		dst, src = dst[len(ks):], src[len(ks):]
+	}
+}
+
+// inexactOverlap reports whether x and y share memory at any non-corresponding index.
+func inexactOverlap(x, y []byte) bool {
+	if len(x) == 0 || len(y) == 0 || &x[0] == &y[0] {
+		return false
+	}
+	xStart, yStart := uintptr(unsafe.Pointer(&x[0])), uintptr(unsafe.Pointer(&y[0]))
+	return xStart <= yStart+uintptr(len(y)-1) && yStart <= xStart+uintptr(len(x)-1)
+}
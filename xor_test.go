@@ -0,0 +1,114 @@
+package fcrand
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+// Test NewXORReader output equals the XOR of its sources
+func TestNewXORReader(t *testing.T) {
+	a := bytes.Repeat([]byte{0x0F, 0xAA}, 700)
+	b := bytes.Repeat([]byte{0xF0, 0x55, 0x01}, 700)
+	r := NewXORReader(bytes.NewReader(a), iotest.OneByteReader(bytes.NewReader(b)))
+
+	buf := make([]byte, 1300) // spans multiple internal chunks
+	n, err := r.Read(buf)
+	if err != nil || n != len(buf) {
+		t.Fatalf("Read returned n=%d, err=%v", n, err)
+	}
+	for i := range buf {
+		if want := a[i] ^ b[i]; buf[i] != want {
+			t.Fatalf("byte %d = %#x, want %#x", i, buf[i], want)
+		}
+	}
+
+	// XOR of two Reader streams is still random
+	buf = make([]byte, 64)
+	if _, err = NewXORReader(Reader, Reader).Read(buf); err != nil {
+		t.Fatalf("Read returned error: %v", err)
+	}
+	if bytes.Equal(buf, make([]byte, 64)) {
+		t.Fatal("Read returned all zero bytes")
+	}
+
+	if raceEnabled {
+		return // allocation counts are unreliable under -race
+	}
+	r = NewXORReader(Reader, Reader)
+	buf = make([]byte, 1300)
+	if allocs := testing.AllocsPerRun(100, func() { r.Read(buf) }); allocs != 0 {
+		t.Fatalf("Read made %v allocations, want 0", allocs)
+	}
+}
+
+// Test NewXORReader propagates source errors
+func TestNewXORReader_Error(t *testing.T) {
+	errSource := errors.New("source failed")
+	r := NewXORReader(Reader, iotest.ErrReader(errSource))
+	if n, err := r.Read(make([]byte, 16)); n != 0 || !errors.Is(err, errSource) {
+		t.Fatalf("Read returned n=%d, err=%v, want 0, %v", n, err, errSource)
+	}
+
+	r = NewXORReader(Reader, bytes.NewReader(make([]byte, 4)))
+	if _, err := r.Read(make([]byte, 16)); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Read on short source returned err=%v, want %v", err, io.ErrUnexpectedEOF)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("NewXORReader with no sources did not panic")
+		}
+	}()
+	NewXORReader()
+}
+
+// Test NewStream masks data non-reproducibly and follows cipher.Stream rules
+func TestNewStream(t *testing.T) {
+	plaintext := bytes.Repeat([]byte("attack at dawn! "), 64) // 1024 bytes, spans chunks
+	masked := make([]byte, len(plaintext)+8)
+	NewStream().XORKeyStream(masked, plaintext)
+	if bytes.Equal(masked[:len(plaintext)], plaintext) {
+		t.Fatal("XORKeyStream did not mask the plaintext")
+	}
+	if !bytes.Equal(masked[len(plaintext):], make([]byte, 8)) {
+		t.Fatal("XORKeyStream wrote past len(src)")
+	}
+
+	unmasked := masked[:len(plaintext)]
+	NewStream().XORKeyStream(unmasked, unmasked) // exact overlap is allowed
+	if bytes.Equal(unmasked, plaintext) {
+		t.Fatal("a second Stream recovered the plaintext")
+	}
+
+	for name, f := range map[string]func(){
+		"short dst":       func() { NewStream().XORKeyStream(make([]byte, 3), make([]byte, 4)) },
+		"inexact overlap": func() { NewStream().XORKeyStream(plaintext[1:], plaintext[:8]) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("XORKeyStream with %s did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+// Test XORRead on zero and non-zero buffers
+func TestXORRead(t *testing.T) {
+	b := make([]byte, 700)
+	XORRead(b)
+	assertHighEntropy(t, b)
+
+	orig := bytes.Repeat([]byte{0x5A}, 64)
+	b = bytes.Clone(orig)
+	XORRead(b)
+	if bytes.Equal(b, orig) {
+		t.Fatal("XORRead did not change the buffer")
+	}
+	XORRead(nil)
+}